@@ -4,14 +4,22 @@ import (
 	"database/sql"
 	"delivery/cmd"
 	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderhistoryrepo"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/roadgraph"
+	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/generated/servers"
+	"delivery/internal/generated/servers/deliverypb"
 	"delivery/internal/pkg/errs"
 	"delivery/internal/pkg/outbox"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
 
+	grpcin "delivery/internal/adapters/in/grpc"
 	httpin "delivery/internal/adapters/in/http"
 
 	"github.com/joho/godotenv"
@@ -21,12 +29,15 @@ import (
 	_ "github.com/lib/pq"
 	oam "github.com/oapi-codegen/echo-middleware"
 	"github.com/robfig/cron/v3"
+	"google.golang.org/grpc"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
 func main() {
 	config := getConfigs()
+	grid := mustSetGrid(config)
+	mustSetDistanceMetric(config, grid)
 
 	connectionString, err := makeConnectionString(
 		config.DbHost,
@@ -55,14 +66,22 @@ func main() {
 	)
 	defer compositionRoot.CloseAll()
 
+	mustBackfill(gormDb, compositionRoot)
+	mustCheckLocations(gormDb, grid)
+
 	startCron(compositionRoot)
 
+	startKafkaConsumer(compositionRoot)
+
+	startGrpcServer(compositionRoot, config.GrpcPort)
+
 	startWebServer(compositionRoot, config.HttpPort)
 }
 
 func getConfigs() cmd.Config {
 	config := cmd.Config{
 		HttpPort:                  goDotEnvVariable("HTTP_PORT"),
+		GrpcPort:                  goDotEnvVariable("GRPC_PORT"),
 		DbHost:                    goDotEnvVariable("DB_HOST"),
 		DbPort:                    goDotEnvVariable("DB_PORT"),
 		DbUser:                    goDotEnvVariable("DB_USER"),
@@ -74,10 +93,108 @@ func getConfigs() cmd.Config {
 		KafkaConsumerGroup:        goDotEnvVariable("KAFKA_CONSUMER_GROUP"),
 		KafkaBasketConfirmedTopic: goDotEnvVariable("KAFKA_BASKET_CONFIRMED_TOPIC"),
 		KafkaOrderChangedTopic:    goDotEnvVariable("KAFKA_ORDER_CHANGED_TOPIC"),
+		DispatchStrategy:          goDotEnvVariable("DISPATCH_STRATEGY"),
+		StorageAllocationStrategy: goDotEnvVariable("STORAGE_ALLOCATION_STRATEGY"),
+		AssignOrdersMode:          goDotEnvVariable("ASSIGN_ORDERS_MODE"),
+		WarehouseLocationX:        goDotEnvVariable("WAREHOUSE_LOCATION_X"),
+		WarehouseLocationY:        goDotEnvVariable("WAREHOUSE_LOCATION_Y"),
+		GridMinX:                  goDotEnvVariable("GRID_MIN_X"),
+		GridMinY:                  goDotEnvVariable("GRID_MIN_Y"),
+		GridMaxX:                  goDotEnvVariable("GRID_MAX_X"),
+		GridMaxY:                  goDotEnvVariable("GRID_MAX_Y"),
+		DistanceMetric:            goDotEnvVariable("DISTANCE_METRIC"),
+		GeoOriginLat:              goDotEnvVariable("GEO_ORIGIN_LAT"),
+		GeoOriginLon:              goDotEnvVariable("GEO_ORIGIN_LON"),
+		GeoCellSizeKm:             goDotEnvVariable("GEO_CELL_SIZE_KM"),
+		RoadGraphPath:             goDotEnvVariable("ROAD_GRAPH_PATH"),
 	}
 	return config
 }
 
+func mustSetGrid(config cmd.Config) kernel.Grid {
+	coordinates := make([]int, 0, 4)
+	for _, value := range []string{config.GridMinX, config.GridMinY, config.GridMaxX, config.GridMaxY} {
+		coordinate, err := strconv.Atoi(value)
+		if err != nil {
+			log.Fatalf("cannot parse grid bounds: %v", err)
+		}
+		coordinates = append(coordinates, coordinate)
+	}
+
+	grid, err := kernel.NewGrid(coordinates[0], coordinates[1], coordinates[2], coordinates[3])
+	if err != nil {
+		log.Fatalf("invalid grid bounds: %v", err)
+	}
+
+	err = kernel.SetGrid(grid)
+	if err != nil {
+		log.Fatalf("cannot set grid: %v", err)
+	}
+	return grid
+}
+
+const (
+	distanceMetricManhattan = "manhattan"
+	distanceMetricHaversine = "haversine"
+	distanceMetricRoad      = "road"
+)
+
+func mustSetDistanceMetric(config cmd.Config, grid kernel.Grid) {
+	var metric kernel.DistanceMetric
+	switch config.DistanceMetric {
+	case "", distanceMetricManhattan:
+		metric = kernel.NewManhattanMetric()
+	case distanceMetricHaversine:
+		projection := mustGeoProjection(config, grid)
+
+		haversine, err := kernel.NewHaversineMetric(projection)
+		if err != nil {
+			log.Fatalf("cannot create haversine metric: %v", err)
+		}
+		metric = haversine
+	case distanceMetricRoad:
+		graph, err := roadgraph.LoadFile(config.RoadGraphPath)
+		if err != nil {
+			log.Fatalf("cannot load road graph: %v", err)
+		}
+
+		router, err := roadgraph.NewRouter(graph)
+		if err != nil {
+			log.Fatalf("cannot create router: %v", err)
+		}
+		metric = router
+	default:
+		log.Fatalf("unknown distance metric: %s", config.DistanceMetric)
+	}
+
+	err := kernel.SetDistanceMetric(metric)
+	if err != nil {
+		log.Fatalf("cannot set distance metric: %v", err)
+	}
+}
+
+func mustGeoProjection(config cmd.Config, grid kernel.Grid) kernel.GeoProjection {
+	values := make([]float64, 0, 3)
+	for _, value := range []string{config.GeoOriginLat, config.GeoOriginLon, config.GeoCellSizeKm} {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			log.Fatalf("cannot parse geo projection: %v", err)
+		}
+		values = append(values, parsed)
+	}
+
+	origin, err := kernel.NewGeoPoint(values[0], values[1])
+	if err != nil {
+		log.Fatalf("invalid geo origin: %v", err)
+	}
+
+	projection, err := kernel.NewGeoProjection(origin, values[2], grid)
+	if err != nil {
+		log.Fatalf("invalid geo projection: %v", err)
+	}
+	return projection
+}
+
 func goDotEnvVariable(key string) string {
 	err := godotenv.Load(".env")
 	if err != nil {
@@ -151,6 +268,10 @@ func mustGormOpen(connectionString string) *gorm.DB {
 }
 
 func mustAutoMigrate(db *gorm.DB) {
+	// курьеры, сохраненные до появления смен, получали заказы без смены и остаются на смене
+	withoutShifts := db.Migrator().HasTable(&courierrepo.CourierDTO{}) &&
+		!db.Migrator().HasColumn(&courierrepo.CourierDTO{}, "Availability")
+
 	err := db.AutoMigrate(&courierrepo.CourierDTO{})
 	if err != nil {
 		log.Fatalf("Ошибка миграции: %v", err)
@@ -161,19 +282,74 @@ func mustAutoMigrate(db *gorm.DB) {
 		log.Fatalf("Ошибка миграции: %v", err)
 	}
 
+	err = db.AutoMigrate(&courierrepo.ShiftDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции: %v", err)
+	}
+
 	err = db.AutoMigrate(&orderrepo.OrderDTO{})
 	if err != nil {
 		log.Fatalf("Ошибка миграции: %v", err)
 	}
 
+	err = db.AutoMigrate(&orderrepo.ItemDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции: %v", err)
+	}
+
+	err = db.AutoMigrate(&orderhistoryrepo.StatusChangeDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции: %v", err)
+	}
+
 	err = db.AutoMigrate(&outbox.Message{})
 	if err != nil {
 		log.Fatalf("Ошибка миграции: %v", err)
 	}
+
+	if withoutShifts {
+		err = courierrepo.StartShiftForAll(db, time.Now())
+		if err != nil {
+			log.Fatalf("Ошибка миграции: %v", err)
+		}
+	}
+}
+
+// mustBackfill заполняет данные, появившиеся в схеме позже, у уже сохраненных строк
+func mustBackfill(db *gorm.DB, compositionRoot *cmd.CompositionRoot) {
+	err := orderrepo.BackfillPickupLocation(db, compositionRoot.NewWarehouseLocation())
+	if err != nil {
+		log.Fatalf("Ошибка миграции: %v", err)
+	}
+
+	err = orderhistoryrepo.BackfillCurrentStatus(db, time.Now())
+	if err != nil {
+		log.Fatalf("Ошибка миграции: %v", err)
+	}
+}
+
+// mustCheckLocations не дает запустить приложение, если после уменьшения карты
+// сохраненные курьеры или незавершенные заказы оказались за ее границами
+func mustCheckLocations(db *gorm.DB, grid kernel.Grid) {
+	couriers, err := courierrepo.CountOutsideGrid(db, grid)
+	if err != nil {
+		log.Fatalf("cannot check courier locations: %v", err)
+	}
+
+	orders, err := orderrepo.CountOutsideGrid(db, grid)
+	if err != nil {
+		log.Fatalf("cannot check order locations: %v", err)
+	}
+
+	if couriers > 0 || orders > 0 {
+		log.Fatalf("grid %d..%d x %d..%d does not contain %d couriers and %d orders, move them inside the grid",
+			grid.MinX(), grid.MaxX(), grid.MinY(), grid.MaxY(), couriers, orders)
+	}
 }
 
 func startWebServer(compositionRoot *cmd.CompositionRoot, port string) {
 	e := echo.New()
+	e.HTTPErrorHandler = httpin.NewErrorHandler(e.DefaultHTTPErrorHandler)
 	e.GET("/health", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Healthy")
 	})
@@ -181,16 +357,23 @@ func startWebServer(compositionRoot *cmd.CompositionRoot, port string) {
 	handlers, err := httpin.NewServer(
 		compositionRoot.NewCreateCourierCommandHandler(),
 		compositionRoot.NewCreateOrderCommandHandler(),
+		compositionRoot.NewCancelOrderCommandHandler(),
+		compositionRoot.NewChangeCourierAvailabilityCommandHandler(),
+		compositionRoot.NewAddStoragePlaceCommandHandler(),
 		compositionRoot.NewGetAllCouriersQueryHandler(),
-		compositionRoot.NewGetNotCompletedOrdersQueryHandler(),
+		compositionRoot.NewGetCourierQueryHandler(),
+		compositionRoot.NewGetOrdersQueryHandler(),
+		compositionRoot.NewGetOrderQueryHandler(),
+		compositionRoot.NewCourierStream(),
 	)
 	if err != nil {
 		log.Fatalf("Ошибка инициализации HTTP Server: %v", err)
 	}
 
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.OPTIONS},
+		AllowOrigins:  []string{"*"},
+		AllowMethods:  []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.OPTIONS},
+		ExposeHeaders: []string{"X-Next-Cursor"},
 	}))
 
 	spec, err := servers.GetSwagger()
@@ -207,6 +390,46 @@ func startWebServer(compositionRoot *cmd.CompositionRoot, port string) {
 	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%s", port), nil))
 }
 
+func startGrpcServer(compositionRoot *cmd.CompositionRoot, port string) {
+	handlers, err := grpcin.NewServer(
+		compositionRoot.NewCreateCourierCommandHandler(),
+		compositionRoot.NewCreateOrderCommandHandler(),
+		compositionRoot.NewGetAllCouriersQueryHandler(),
+		compositionRoot.NewGetOrderQueryHandler(),
+		compositionRoot.NewCourierStream(),
+	)
+	if err != nil {
+		log.Fatalf("Ошибка инициализации gRPC Server: %v", err)
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%s", port))
+	if err != nil {
+		log.Fatalf("Ошибка запуска gRPC Server: %v", err)
+	}
+
+	server := grpc.NewServer()
+	deliverypb.RegisterDeliveryServer(server, handlers)
+	// поток курьеров зарегистрирован раньше и закроется первым, поэтому открытые
+	// WatchCourier завершатся и не задержат остановку сервера
+	compositionRoot.RegisterCloser(grpcServerCloser{server: server})
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Errorf("ошибка gRPC Server: %v", err)
+		}
+	}()
+}
+
+// grpcServerCloser останавливает gRPC Server, дожидаясь завершения начатых вызовов
+type grpcServerCloser struct {
+	server *grpc.Server
+}
+
+func (c grpcServerCloser) Close() error {
+	c.server.GracefulStop()
+	return nil
+}
+
 func registerSwaggerOpenApi(e *echo.Echo) {
 	e.GET("/openapi.json", func(c echo.Context) error {
 		swagger, err := servers.GetSwagger()
@@ -258,10 +481,25 @@ func startCron(compositionRoot *cmd.CompositionRoot) {
 		log.Fatalf("ошибка при добавлении задачи: %v", err)
 	}
 
-	_, err = c.AddJob("@every 1s", compositionRoot.NewMoveCouriersJob())
+	_, err = c.AddJob("@every "+kernel.StepDuration.String(), compositionRoot.NewMoveCouriersJob())
+	if err != nil {
+		log.Fatalf("ошибка при добавлении задачи: %v", err)
+	}
+
+	_, err = c.AddJob("@every 1s", compositionRoot.NewOutboxJob())
 	if err != nil {
 		log.Fatalf("ошибка при добавлении задачи: %v", err)
 	}
 
 	c.Start()
 }
+
+func startKafkaConsumer(compositionRoot *cmd.CompositionRoot) {
+	consumer := compositionRoot.NewBasketConfirmedConsumer()
+
+	go func() {
+		if err := consumer.Consume(); err != nil {
+			log.Errorf("ошибка чтения из Kafka: %v", err)
+		}
+	}()
+}
@@ -1,14 +1,24 @@
 package cmd
 
 import (
+	"delivery/internal/adapters/in/kafka"
+	"delivery/internal/adapters/in/stream"
 	"delivery/internal/adapters/out/grpc/geo"
+	kafkaout "delivery/internal/adapters/out/kafka"
 	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/application/eventhandlers"
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
 	"delivery/internal/jobs"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/outbox"
 	"log"
+	"reflect"
+	"strconv"
 	"sync"
 
 	"github.com/robfig/cron/v3"
@@ -16,11 +26,21 @@ import (
 )
 
 type CompositionRoot struct {
-	configs   Config
-	gormDb    *gorm.DB
-	geoClient ports.GeoClient
-	onceGeo   sync.Once
-	closers   []Closer
+	configs           Config
+	gormDb            *gorm.DB
+	geoClient         ports.GeoClient
+	onceGeo           sync.Once
+	orderProducer     ports.OrderProducer
+	onceOrderProducer sync.Once
+	eventRegistry     outbox.EventRegistry
+	onceEventRegistry sync.Once
+	mediatr           ddd.Mediatr
+	onceMediatr       sync.Once
+	orderDispatcher   services.OrderDispatcher
+	onceDispatcher    sync.Once
+	courierStream     *stream.Hub
+	onceCourierStream sync.Once
+	closers           []Closer
 }
 
 func NewCompositionRoot(configs Config, gormDb *gorm.DB) *CompositionRoot {
@@ -31,12 +51,23 @@ func NewCompositionRoot(configs Config, gormDb *gorm.DB) *CompositionRoot {
 }
 
 func (cr *CompositionRoot) NewOrderDispatcher() services.OrderDispatcher {
-	orderDispatcher := services.NewOrderDispatcher()
-	return orderDispatcher
+	cr.onceDispatcher.Do(func() {
+		orderDispatcher, err := services.NewOrderDispatcherByStrategy(
+			services.DispatchStrategy(cr.configs.DispatchStrategy))
+		if err != nil {
+			log.Fatalf("cannot create OrderDispatcher: %v", err)
+		}
+		cr.orderDispatcher = orderDispatcher
+	})
+	return cr.orderDispatcher
+}
+
+func (cr *CompositionRoot) NewBatchOrderDispatcher() services.BatchOrderDispatcher {
+	return services.NewBatchOrderDispatcher()
 }
 
 func (cr *CompositionRoot) NewUnitOfWork() ports.UnitOfWork {
-	unitOfWork, err := postgres.NewUnitOfWork(cr.gormDb)
+	unitOfWork, err := postgres.NewUnitOfWork(cr.gormDb, cr.NewMediatr())
 	if err != nil {
 		log.Fatalf("cannot create UnitOfWork: %v", err)
 	}
@@ -44,29 +75,87 @@ func (cr *CompositionRoot) NewUnitOfWork() ports.UnitOfWork {
 }
 
 func (cr *CompositionRoot) NewUnitOfWorkFactory() ports.UnitOfWorkFactory {
-	unitOfWorkFactory, err := postgres.NewUnitOfWorkFactory(cr.gormDb)
+	unitOfWorkFactory, err := postgres.NewUnitOfWorkFactory(cr.gormDb, cr.NewMediatr())
 	if err != nil {
 		log.Fatalf("cannot create UnitOfWorkFactory: %v", err)
 	}
 	return unitOfWorkFactory
 }
 func (cr *CompositionRoot) NewCreateOrderCommandHandler() commands.CreateOrderCommandHandler {
-	commandHandler, err := commands.NewCreateOrderCommandHandler(cr.NewUnitOfWorkFactory(), cr.NewGeoClient())
+	commandHandler, err := commands.NewCreateOrderCommandHandler(
+		cr.NewUnitOfWorkFactory(), cr.NewGeoClient(), cr.NewWarehouseLocation())
 	if err != nil {
 		log.Fatalf("cannot create CreateOrderCommandHandler: %v", err)
 	}
 	return commandHandler
 }
 
+func (cr *CompositionRoot) NewChangeCourierAvailabilityCommandHandler() commands.ChangeCourierAvailabilityCommandHandler {
+	commandHandler, err := commands.NewChangeCourierAvailabilityCommandHandler(cr.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("cannot create ChangeCourierAvailabilityCommandHandler: %v", err)
+	}
+	return commandHandler
+}
+
+func (cr *CompositionRoot) NewAddStoragePlaceCommandHandler() commands.AddStoragePlaceCommandHandler {
+	commandHandler, err := commands.NewAddStoragePlaceCommandHandler(cr.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("cannot create AddStoragePlaceCommandHandler: %v", err)
+	}
+	return commandHandler
+}
+
+func (cr *CompositionRoot) NewWarehouseLocation() kernel.Location {
+	x, err := strconv.Atoi(cr.configs.WarehouseLocationX)
+	if err != nil {
+		log.Fatalf("cannot parse warehouse location x: %v", err)
+	}
+
+	y, err := strconv.Atoi(cr.configs.WarehouseLocationY)
+	if err != nil {
+		log.Fatalf("cannot parse warehouse location y: %v", err)
+	}
+
+	location, err := kernel.NewLocation(x, y)
+	if err != nil {
+		log.Fatalf("cannot create warehouse location: %v", err)
+	}
+	return location
+}
+
+func (cr *CompositionRoot) NewCancelOrderCommandHandler() commands.CancelOrderCommandHandler {
+	commandHandler, err := commands.NewCancelOrderCommandHandler(cr.NewUnitOfWorkFactory())
+	if err != nil {
+		log.Fatalf("cannot create CancelOrderCommandHandler: %v", err)
+	}
+	return commandHandler
+}
+
 func (cr *CompositionRoot) NewCreateCourierCommandHandler() commands.CreateCourierCommandHandler {
-	commandHandler, err := commands.NewCreateCourierCommandHandler(cr.NewUnitOfWorkFactory())
+	commandHandler, err := commands.NewCreateCourierCommandHandler(
+		cr.NewUnitOfWorkFactory(),
+		courier.AllocationStrategy(cr.configs.StorageAllocationStrategy))
 	if err != nil {
 		log.Fatalf("cannot create CreateCourierCommandHandler: %v", err)
 	}
 	return commandHandler
 }
 
+// assignOrdersModeBatch включает распределение всех созданных заказов за один запуск джоба,
+// в остальных случаях за запуск назначается один заказ
+const assignOrdersModeBatch = "batch"
+
 func (cr *CompositionRoot) NewAssignOrdersCommandHandler() commands.AssignOrdersCommandHandler {
+	if cr.configs.AssignOrdersMode == assignOrdersModeBatch {
+		commandHandler, err := commands.NewAssignOrdersBatchCommandHandler(
+			cr.NewUnitOfWorkFactory(), cr.NewBatchOrderDispatcher())
+		if err != nil {
+			log.Fatalf("cannot create AssignOrdersBatchCommandHandler: %v", err)
+		}
+		return commandHandler
+	}
+
 	commandHandler, err := commands.NewAssignOrdersCommandHandler(
 		cr.NewUnitOfWorkFactory(), cr.NewOrderDispatcher())
 	if err != nil {
@@ -92,10 +181,26 @@ func (cr *CompositionRoot) NewGetAllCouriersQueryHandler() queries.GetAllCourier
 	return queryHandler
 }
 
-func (cr *CompositionRoot) NewGetNotCompletedOrdersQueryHandler() queries.GetNotCompletedOrdersQueryHandler {
-	queryHandler, err := queries.NewGetNotCompletedOrdersQueryHandler(cr.gormDb)
+func (cr *CompositionRoot) NewGetCourierQueryHandler() queries.GetCourierQueryHandler {
+	queryHandler, err := queries.NewGetCourierQueryHandler(cr.gormDb)
 	if err != nil {
-		log.Fatalf("cannot create GetNotCompletedOrdersQueryHandler: %v", err)
+		log.Fatalf("cannot create GetCourierQueryHandler: %v", err)
+	}
+	return queryHandler
+}
+
+func (cr *CompositionRoot) NewGetOrdersQueryHandler() queries.GetOrdersQueryHandler {
+	queryHandler, err := queries.NewGetOrdersQueryHandler(cr.gormDb)
+	if err != nil {
+		log.Fatalf("cannot create GetOrdersQueryHandler: %v", err)
+	}
+	return queryHandler
+}
+
+func (cr *CompositionRoot) NewGetOrderQueryHandler() queries.GetOrderQueryHandler {
+	queryHandler, err := queries.NewGetOrderQueryHandler(cr.gormDb)
+	if err != nil {
+		log.Fatalf("cannot create GetOrderQueryHandler: %v", err)
 	}
 	return queryHandler
 }
@@ -116,6 +221,69 @@ func (cr *CompositionRoot) NewMoveCouriersJob() cron.Job {
 	return job
 }
 
+func (cr *CompositionRoot) NewOutboxJob() cron.Job {
+	job, err := jobs.NewOutboxJob(cr.NewUnitOfWorkFactory(), cr.NewEventRegistry(), cr.NewOrderProducer())
+	if err != nil {
+		log.Fatalf("cannot create OutboxJob: %v", err)
+	}
+	return job
+}
+
+func (cr *CompositionRoot) NewMediatr() ddd.Mediatr {
+	cr.onceMediatr.Do(func() {
+		mediatr := ddd.NewMediatr()
+		mediatr.SubscribePreCommit(eventhandlers.NewOrderStatusHistoryHandler(), eventhandlers.OrderStatusChangedEvents...)
+		mediatr.SubscribePreCommit(eventhandlers.NewOutboxHandler(), eventhandlers.OrderStatusChangedEvents...)
+		mediatr.Subscribe(cr.NewCourierStream(), stream.Events...)
+
+		cr.mediatr = mediatr
+	})
+	return cr.mediatr
+}
+
+func (cr *CompositionRoot) NewCourierStream() *stream.Hub {
+	cr.onceCourierStream.Do(func() {
+		hub := stream.NewHub()
+
+		cr.RegisterCloser(hub)
+		cr.courierStream = hub
+	})
+	return cr.courierStream
+}
+
+func (cr *CompositionRoot) NewEventRegistry() outbox.EventRegistry {
+	cr.onceEventRegistry.Do(func() {
+		eventRegistry, err := outbox.NewEventRegistry()
+		if err != nil {
+			log.Fatalf("cannot create EventRegistry: %v", err)
+		}
+
+		// в outbox попадают только события смены статуса заказа, см. NewMediatr
+		for _, domainEvent := range eventhandlers.OrderStatusChangedEvents {
+			err = eventRegistry.RegisterDomainEvent(reflect.TypeOf(domainEvent))
+			if err != nil {
+				log.Fatalf("cannot register domain event: %v", err)
+			}
+		}
+
+		cr.eventRegistry = eventRegistry
+	})
+	return cr.eventRegistry
+}
+
+func (cr *CompositionRoot) NewOrderProducer() ports.OrderProducer {
+	cr.onceOrderProducer.Do(func() {
+		producer, err := kafkaout.NewOrderProducer([]string{cr.configs.KafkaHost}, cr.configs.KafkaOrderChangedTopic)
+		if err != nil {
+			log.Fatalf("cannot create OrderProducer: %v", err)
+		}
+
+		cr.RegisterCloser(producer)
+		cr.orderProducer = producer
+	})
+	return cr.orderProducer
+}
+
 func (cr *CompositionRoot) NewGeoClient() ports.GeoClient {
 	cr.onceGeo.Do(func() {
 		client, err := geo.NewClient(cr.configs.GeoServiceGrpcHost)
@@ -128,3 +296,18 @@ func (cr *CompositionRoot) NewGeoClient() ports.GeoClient {
 	})
 	return cr.geoClient
 }
+
+func (cr *CompositionRoot) NewBasketConfirmedConsumer() kafka.BasketConfirmedConsumer {
+	consumer, err := kafka.NewBasketConfirmedConsumer(
+		[]string{cr.configs.KafkaHost},
+		cr.configs.KafkaConsumerGroup,
+		cr.configs.KafkaBasketConfirmedTopic,
+		cr.NewCreateOrderCommandHandler(),
+	)
+	if err != nil {
+		log.Fatalf("cannot create BasketConfirmedConsumer: %v", err)
+	}
+
+	cr.RegisterCloser(consumer)
+	return consumer
+}
@@ -2,6 +2,7 @@ package cmd
 
 type Config struct {
 	HttpPort                  string
+	GrpcPort                  string
 	DbHost                    string
 	DbPort                    string
 	DbUser                    string
@@ -13,4 +14,18 @@ type Config struct {
 	KafkaConsumerGroup        string
 	KafkaBasketConfirmedTopic string
 	KafkaOrderChangedTopic    string
+	DispatchStrategy          string
+	StorageAllocationStrategy string
+	AssignOrdersMode          string
+	WarehouseLocationX        string
+	WarehouseLocationY        string
+	GridMinX                  string
+	GridMinY                  string
+	GridMaxX                  string
+	GridMaxY                  string
+	DistanceMetric            string
+	GeoOriginLat              string
+	GeoOriginLon              string
+	GeoCellSizeKm             string
+	RoadGraphPath             string
 }
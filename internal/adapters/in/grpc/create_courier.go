@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/generated/servers/deliverypb"
+)
+
+func (s *Server) CreateCourier(
+	ctx context.Context,
+	request *deliverypb.CreateCourierRequest) (*deliverypb.CreateCourierReply, error) {
+	vehicle := courier.VehicleFoot
+	if request.GetVehicleType() != "" {
+		parsed, err := courier.ParseVehicleType(request.GetVehicleType())
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+		vehicle = parsed
+	}
+
+	command, err := commands.NewCreateCourierCommand(request.GetName(), vehicle, int(request.GetSpeed()))
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	err = s.createCourierCommandHandler.Handle(ctx, command)
+	if err != nil {
+		return nil, toCommandError(err)
+	}
+
+	return &deliverypb.CreateCourierReply{}, nil
+}
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/generated/servers/deliverypb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+func (s *Server) CreateOrder(
+	ctx context.Context,
+	request *deliverypb.CreateOrderRequest) (*deliverypb.CreateOrderReply, error) {
+	orderID, err := uuid.Parse(request.GetId())
+	if err != nil {
+		return nil, invalidArgument(errs.NewValueIsInvalidError("id"))
+	}
+
+	details, err := toOrderDetails(request)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	terms, err := toOrderTerms(request)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	command, err := commands.NewCreateOrderCommand(orderID, request.GetStreet(), int(request.GetVolume()), details, terms)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	response, err := s.createOrderCommandHandler.Handle(ctx, command)
+	if err != nil {
+		return nil, toCommandError(err)
+	}
+
+	return &deliverypb.CreateOrderReply{
+		Id:       response.OrderID.String(),
+		Location: &deliverypb.Location{X: int64(response.Location.X()), Y: int64(response.Location.Y())},
+		Created:  response.Created,
+	}, nil
+}
+
+func toOrderDetails(request *deliverypb.CreateOrderRequest) (commands.OrderDetails, error) {
+	details := commands.OrderDetails{
+		Weight: int(request.GetWeight()),
+		Length: int(request.GetDimensions().GetLength()),
+		Width:  int(request.GetDimensions().GetWidth()),
+		Height: int(request.GetDimensions().GetHeight()),
+	}
+
+	if len(request.GetItems()) > 0 {
+		details.Items = make([]commands.OrderItem, 0, len(request.GetItems()))
+	}
+
+	for _, item := range request.GetItems() {
+		goodID, err := uuid.Parse(item.GetGoodId())
+		if err != nil {
+			return commands.OrderDetails{}, errs.NewValueIsInvalidError("goodId")
+		}
+
+		details.Items = append(details.Items, commands.OrderItem{
+			GoodID:   goodID,
+			Title:    item.GetTitle(),
+			Quantity: int(item.GetQuantity()),
+		})
+	}
+
+	return details, nil
+}
+
+func toOrderTerms(request *deliverypb.CreateOrderRequest) (commands.OrderTerms, error) {
+	var terms commands.OrderTerms
+	if request.GetPriority() != "" {
+		priority, err := order.ParsePriority(request.GetPriority())
+		if err != nil {
+			return commands.OrderTerms{}, err
+		}
+		terms.Priority = priority
+	}
+
+	// пустой Timestamp - не начало эпохи, а незаданная граница интервала
+	window := request.GetDeliveryWindow()
+	if window.GetFrom() != nil {
+		terms.DeliveryFrom = window.GetFrom().AsTime()
+	}
+
+	if window.GetTo() != nil {
+		terms.DeliveryTo = window.GetTo().AsTime()
+	}
+
+	return terms, nil
+}
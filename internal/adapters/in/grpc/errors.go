@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toCommandError переводит ошибку обработчика команды в статус gRPC так же,
+// как HTTP API переводит ее в problem details
+func toCommandError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrObjectNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errs.ErrVersionIsInvalid):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, commands.ErrOrderAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case isValidationError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case isUnavailable(err):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toQueryError(err error) error {
+	if errors.Is(err, errs.ErrObjectNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func invalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+func isValidationError(err error) bool {
+	return errors.Is(err, errs.ErrValueIsInvalid) ||
+		errors.Is(err, errs.ErrValueIsRequired) ||
+		errors.Is(err, errs.ErrValueIsOutOfRange)
+}
+
+// isUnavailable проверяет, что внешний сервис, например сервис геолокации,
+// не ответил вовремя или недоступен и запрос можно повторить позже
+func isUnavailable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	s, ok := status.FromError(err)
+	return ok && (s.Code() == codes.Unavailable || s.Code() == codes.DeadlineExceeded)
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToCommandError(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{name: "Not found", err: errs.NewObjectNotFoundError("order", uuid.New()), want: codes.NotFound},
+		{name: "Lost update", err: errs.NewVersionIsInvalidError("order", nil), want: codes.Aborted},
+		{name: "Replay with other data", err: commands.ErrOrderAlreadyExists, want: codes.AlreadyExists},
+		{name: "Invalid value", err: errs.NewValueIsInvalidError("volume"), want: codes.InvalidArgument},
+		{name: "Required value", err: errs.NewValueIsRequiredError("street"), want: codes.InvalidArgument},
+		{name: "Geo service is down", err: status.Error(codes.Unavailable, "connection refused"), want: codes.Unavailable},
+		{name: "Geo service timeout", err: fmt.Errorf("geo: %w", context.DeadlineExceeded), want: codes.Unavailable},
+		{name: "Database failure", err: errors.New("connection reset by peer"), want: codes.Internal},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, status.Code(toCommandError(tt.err)))
+		})
+	}
+}
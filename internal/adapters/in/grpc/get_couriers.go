@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/generated/servers/deliverypb"
+)
+
+func (s *Server) GetCouriers(
+	_ context.Context,
+	request *deliverypb.GetCouriersRequest) (*deliverypb.GetCouriersReply, error) {
+	filter := queries.CouriersFilter{
+		Busy:           request.Busy,
+		Availabilities: toAvailabilities(request.GetAvailability()),
+		Area: queries.Area{
+			MinX: toIntPtr(request.MinX),
+			MinY: toIntPtr(request.MinY),
+			MaxX: toIntPtr(request.MaxX),
+			MaxY: toIntPtr(request.MaxY),
+		},
+	}
+
+	pageRequest := queries.PageRequest{
+		Cursor: request.GetCursor(),
+		Limit:  int(request.GetLimit()),
+		Sort:   request.GetSort(),
+	}
+
+	query, err := queries.NewGetAllCouriersQuery(filter, pageRequest)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	queryResponse, err := s.getAllCouriersQueryHandler.Handle(query)
+	if err != nil {
+		return nil, toQueryError(err)
+	}
+
+	couriers := make([]*deliverypb.Courier, 0, len(queryResponse.Couriers))
+	for _, courier := range queryResponse.Couriers {
+		couriers = append(couriers, &deliverypb.Courier{
+			Id:           courier.ID.String(),
+			Name:         courier.Name,
+			Location:     &deliverypb.Location{X: int64(courier.Location.X), Y: int64(courier.Location.Y)},
+			VehicleType:  courier.VehicleType,
+			Availability: courier.Availability,
+		})
+	}
+
+	return &deliverypb.GetCouriersReply{
+		Couriers:   couriers,
+		NextCursor: queryResponse.NextCursor,
+	}, nil
+}
+
+func toAvailabilities(values []string) []courier.Availability {
+	if len(values) == 0 {
+		return nil
+	}
+
+	availabilities := make([]courier.Availability, 0, len(values))
+	for _, value := range values {
+		availabilities = append(availabilities, courier.Availability(value))
+	}
+
+	return availabilities
+}
+
+func toIntPtr(value *int64) *int {
+	if value == nil {
+		return nil
+	}
+
+	v := int(*value)
+	return &v
+}
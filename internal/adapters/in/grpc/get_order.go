@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/generated/servers/deliverypb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) GetOrder(
+	ctx context.Context,
+	request *deliverypb.GetOrderRequest) (*deliverypb.GetOrderReply, error) {
+	orderID, err := uuid.Parse(request.GetOrderId())
+	if err != nil {
+		return nil, invalidArgument(errs.NewValueIsInvalidError("orderId"))
+	}
+
+	query, err := queries.NewGetOrderQuery(orderID)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	queryResponse, err := s.getOrderQueryHandler.Handle(ctx, query)
+	if err != nil {
+		return nil, toQueryError(err)
+	}
+
+	history := make([]*deliverypb.OrderStatusChange, 0, len(queryResponse.History))
+	for _, change := range queryResponse.History {
+		history = append(history, &deliverypb.OrderStatusChange{
+			Status:    change.Status,
+			ChangedAt: timestamppb.New(change.ChangedAt),
+		})
+	}
+
+	reply := &deliverypb.GetOrderReply{
+		Id:     queryResponse.ID.String(),
+		Status: queryResponse.Status,
+		Volume: int32(queryResponse.Volume),
+		Location: &deliverypb.Location{
+			X: int64(queryResponse.Location.X),
+			Y: int64(queryResponse.Location.Y),
+		},
+		History: history,
+	}
+
+	if queryResponse.CourierID != nil {
+		reply.CourierId = queryResponse.CourierID.String()
+	}
+
+	return reply, nil
+}
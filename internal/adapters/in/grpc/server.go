@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"delivery/internal/adapters/in/stream"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/generated/servers/deliverypb"
+	"delivery/internal/pkg/errs"
+)
+
+var _ deliverypb.DeliveryServer = &Server{}
+
+// Server - входящий gRPC API. Повторяет HTTP API и использует те же обработчики команд и запросов
+type Server struct {
+	deliverypb.UnimplementedDeliveryServer
+
+	createCourierCommandHandler commands.CreateCourierCommandHandler
+	createOrderCommandHandler   commands.CreateOrderCommandHandler
+
+	getAllCouriersQueryHandler queries.GetAllCouriersQueryHandler
+	getOrderQueryHandler       queries.GetOrderQueryHandler
+
+	courierStream *stream.Hub
+}
+
+func NewServer(
+	createCourierCommandHandler commands.CreateCourierCommandHandler,
+	createOrderCommandHandler commands.CreateOrderCommandHandler,
+	getAllCouriersQueryHandler queries.GetAllCouriersQueryHandler,
+	getOrderQueryHandler queries.GetOrderQueryHandler,
+	courierStream *stream.Hub,
+) (*Server, error) {
+	if createCourierCommandHandler == nil {
+		return nil, errs.NewValueIsRequiredError("createCourierCommandHandler")
+	}
+
+	if createOrderCommandHandler == nil {
+		return nil, errs.NewValueIsRequiredError("createOrderCommandHandler")
+	}
+
+	if getAllCouriersQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getAllCouriersQueryHandler")
+	}
+
+	if getOrderQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getOrderQueryHandler")
+	}
+
+	if courierStream == nil {
+		return nil, errs.NewValueIsRequiredError("courierStream")
+	}
+
+	return &Server{
+		createCourierCommandHandler: createCourierCommandHandler,
+		createOrderCommandHandler:   createOrderCommandHandler,
+		getAllCouriersQueryHandler:  getAllCouriersQueryHandler,
+		getOrderQueryHandler:        getOrderQueryHandler,
+		courierStream:               courierStream,
+	}, nil
+}
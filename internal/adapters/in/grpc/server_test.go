@@ -0,0 +1,273 @@
+package grpc
+
+import (
+	"context"
+	"delivery/internal/adapters/in/stream"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/generated/servers/deliverypb"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tests"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestServer_CreateOrder(t *testing.T) {
+	orderID, goodID := uuid.New(), uuid.New()
+	from := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(2 * time.Hour)
+
+	handlers := newFakeHandlers()
+	client := startServer(t, handlers)
+
+	reply, err := client.CreateOrder(context.Background(), &deliverypb.CreateOrderRequest{
+		Id:       orderID.String(),
+		Street:   "Тверская",
+		Volume:   3,
+		Weight:   2,
+		Items:    []*deliverypb.Item{{GoodId: goodID.String(), Title: "Кофе", Quantity: 2}},
+		Priority: "high",
+		DeliveryWindow: &deliverypb.DeliveryWindow{
+			From: timestamppb.New(from),
+			To:   timestamppb.New(to),
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, orderID.String(), reply.GetId())
+	assert.Equal(t, int64(2), reply.GetLocation().GetX())
+	assert.True(t, reply.GetCreated())
+
+	require.Len(t, handlers.createOrder.commands, 1)
+	command := handlers.createOrder.commands[0]
+	assert.Equal(t, "Тверская", command.Street())
+	assert.Equal(t, 2, command.Details().Weight)
+	assert.Equal(t, []commands.OrderItem{{GoodID: goodID, Title: "Кофе", Quantity: 2}}, command.Details().Items)
+	assert.Equal(t, order.PriorityHigh, command.Terms().Priority)
+	assert.True(t, from.Equal(command.Terms().DeliveryFrom))
+	assert.True(t, to.Equal(command.Terms().DeliveryTo))
+}
+
+func TestServer_MapsErrorsToStatusCodes(t *testing.T) {
+	handlers := newFakeHandlers()
+	handlers.createCourier.err = errs.NewVersionIsInvalidError("courier", nil)
+	client := startServer(t, handlers)
+	ctx := context.Background()
+
+	_, err := client.CreateOrder(ctx, &deliverypb.CreateOrderRequest{Id: "not-a-uuid", Street: "Тверская", Volume: 3})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CreateCourier(ctx, &deliverypb.CreateCourierRequest{Name: "Alice", VehicleType: "rocket"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CreateCourier(ctx, &deliverypb.CreateCourierRequest{Name: "Alice"})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	_, err = client.GetOrder(ctx, &deliverypb.GetOrderRequest{OrderId: uuid.NewString()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.GetCouriers(ctx, &deliverypb.GetCouriersRequest{Limit: queries.MaxPageLimit + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_GetCouriers(t *testing.T) {
+	handlers := newFakeHandlers()
+	courierID := uuid.New()
+	handlers.getAllCouriers.response = queries.GetAllCouriersResponse{
+		Couriers: []queries.CourierResponse{{
+			ID:           courierID,
+			Name:         "Alice",
+			Location:     queries.LocationResponse{X: 3, Y: 4},
+			VehicleType:  string(courier.VehicleBicycle),
+			Availability: "available",
+		}},
+		NextCursor: "next",
+	}
+	client := startServer(t, handlers)
+
+	busy, minX := false, int64(2)
+	reply, err := client.GetCouriers(context.Background(), &deliverypb.GetCouriersRequest{
+		Busy:         &busy,
+		MinX:         &minX,
+		Availability: []string{"on_break"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, reply.GetCouriers(), 1)
+	assert.Equal(t, courierID.String(), reply.GetCouriers()[0].GetId())
+	assert.Equal(t, int64(4), reply.GetCouriers()[0].GetLocation().GetY())
+	assert.Equal(t, "next", reply.GetNextCursor())
+
+	require.Len(t, handlers.getAllCouriers.queries, 1)
+	filter := handlers.getAllCouriers.queries[0].Filter()
+	assert.Equal(t, &busy, filter.Busy)
+	assert.Equal(t, []courier.Availability{courier.AvailabilityOnBreak}, filter.Availabilities)
+	assert.Equal(t, 2, *filter.Area.MinX)
+	assert.Nil(t, filter.Area.MaxX)
+}
+
+func TestServer_WatchCourier(t *testing.T) {
+	handlers := newFakeHandlers()
+	client := startServer(t, handlers)
+
+	alice := tests.CreateCourier("Alice", 1, tests.CreateLocation(2, 2))
+	bob := tests.CreateCourier("Bob", 1, tests.CreateLocation(5, 5))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watch, err := client.WatchCourier(ctx, &deliverypb.WatchCourierRequest{CourierIds: []string{alice.Id().String()}})
+	require.NoError(t, err)
+
+	events := []courier.CourierMovedDomainEvent{movedEvent(t, bob), movedEvent(t, alice)}
+
+	// подписка создается на сервере не сразу, поэтому события публикуются, пока клиент их не получит
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, e := range events {
+					_ = handlers.courierStream.Handle(ctx, e)
+				}
+			}
+		}
+	}()
+
+	event, err := watch.Recv()
+	require.NoError(t, err)
+
+	moved := event.GetCourierMoved()
+	require.NotNil(t, moved)
+	assert.Equal(t, alice.Id().String(), moved.GetCourierId())
+	assert.Equal(t, int64(2), moved.GetLocation().GetX())
+}
+
+func TestServer_WatchCourier_ClosedStream(t *testing.T) {
+	handlers := newFakeHandlers()
+	client := startServer(t, handlers)
+	require.NoError(t, handlers.courierStream.Close())
+
+	watch, err := client.WatchCourier(context.Background(), &deliverypb.WatchCourierRequest{})
+	require.NoError(t, err)
+
+	_, err = watch.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func startServer(t *testing.T, handlers *fakeHandlers) deliverypb.DeliveryClient {
+	t.Helper()
+
+	server, err := NewServer(
+		handlers.createCourier,
+		handlers.createOrder,
+		handlers.getAllCouriers,
+		handlers.getOrder,
+		handlers.courierStream,
+	)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	deliverypb.RegisterDeliveryServer(grpcServer, server)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return deliverypb.NewDeliveryClient(conn)
+}
+
+func movedEvent(t *testing.T, c *courier.Courier) courier.CourierMovedDomainEvent {
+	t.Helper()
+
+	from, err := kernel.NewLocation(1, 1)
+	require.NoError(t, err)
+
+	return courier.NewCourierMovedDomainEvent(c, from)
+}
+
+type fakeHandlers struct {
+	createCourier  *fakeCreateCourierCommandHandler
+	createOrder    *fakeCreateOrderCommandHandler
+	getAllCouriers *fakeGetAllCouriersQueryHandler
+	getOrder       *fakeGetOrderQueryHandler
+	courierStream  *stream.Hub
+}
+
+func newFakeHandlers() *fakeHandlers {
+	return &fakeHandlers{
+		createCourier:  &fakeCreateCourierCommandHandler{},
+		createOrder:    &fakeCreateOrderCommandHandler{},
+		getAllCouriers: &fakeGetAllCouriersQueryHandler{},
+		getOrder:       &fakeGetOrderQueryHandler{},
+		courierStream:  stream.NewHub(),
+	}
+}
+
+type fakeCreateCourierCommandHandler struct {
+	err error
+}
+
+func (h *fakeCreateCourierCommandHandler) Handle(_ context.Context, _ commands.CreateCourierCommand) error {
+	return h.err
+}
+
+type fakeCreateOrderCommandHandler struct {
+	commands []commands.CreateOrderCommand
+}
+
+func (h *fakeCreateOrderCommandHandler) Handle(
+	_ context.Context,
+	command commands.CreateOrderCommand) (commands.CreateOrderResponse, error) {
+	h.commands = append(h.commands, command)
+
+	location, err := kernel.NewLocation(2, 3)
+	if err != nil {
+		return commands.CreateOrderResponse{}, err
+	}
+
+	return commands.CreateOrderResponse{OrderID: command.OrderID(), Location: location, Created: true}, nil
+}
+
+type fakeGetAllCouriersQueryHandler struct {
+	queries  []queries.GetAllCouriersQuery
+	response queries.GetAllCouriersResponse
+}
+
+func (h *fakeGetAllCouriersQueryHandler) Handle(query queries.GetAllCouriersQuery) (queries.GetAllCouriersResponse, error) {
+	h.queries = append(h.queries, query)
+	return h.response, nil
+}
+
+type fakeGetOrderQueryHandler struct{}
+
+func (h *fakeGetOrderQueryHandler) Handle(_ context.Context, query queries.GetOrderQuery) (queries.GetOrderResponse, error) {
+	return queries.GetOrderResponse{}, errs.NewObjectNotFoundError("orderID", query.OrderID())
+}
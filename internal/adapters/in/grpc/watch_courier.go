@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"delivery/internal/adapters/in/stream"
+	"delivery/internal/generated/servers/deliverypb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) WatchCourier(
+	request *deliverypb.WatchCourierRequest,
+	server deliverypb.Delivery_WatchCourierServer) error {
+	courierIDs := make([]uuid.UUID, 0, len(request.GetCourierIds()))
+	for _, value := range request.GetCourierIds() {
+		courierID, err := uuid.Parse(value)
+		if err != nil {
+			return invalidArgument(errs.NewValueIsInvalidError("courierIds"))
+		}
+		courierIDs = append(courierIDs, courierID)
+	}
+
+	subscription, err := s.courierStream.Subscribe(courierIDs)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer s.courierStream.Unsubscribe(subscription)
+
+	for {
+		select {
+		case <-server.Context().Done():
+			return nil
+		case message, ok := <-subscription.Messages():
+			// Hub закрывает подписку при остановке или если клиент не успевает читать,
+			// Unavailable подсказывает клиенту переподключиться
+			if !ok {
+				return status.Error(codes.Unavailable, "courier stream is closed")
+			}
+
+			event, ok := toCourierEvent(message)
+			if !ok {
+				continue
+			}
+
+			if err := server.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toCourierEvent(message stream.Message) (*deliverypb.CourierEvent, bool) {
+	switch payload := message.Payload.(type) {
+	case stream.CourierMovedEvent:
+		return &deliverypb.CourierEvent{
+			Event: &deliverypb.CourierEvent_CourierMoved{
+				CourierMoved: &deliverypb.CourierMoved{
+					CourierId:  payload.CourierID.String(),
+					Location:   &deliverypb.Location{X: int64(payload.Location.X), Y: int64(payload.Location.Y)},
+					OccurredAt: timestamppb.New(payload.OccurredAt),
+				},
+			},
+		}, true
+	case stream.OrderStatusChangedEvent:
+		changed := &deliverypb.OrderStatusChanged{
+			OrderId:    payload.OrderID.String(),
+			Status:     string(payload.Status),
+			OccurredAt: timestamppb.New(payload.OccurredAt),
+		}
+
+		if payload.CourierID != nil {
+			changed.CourierId = payload.CourierID.String()
+		}
+
+		return &deliverypb.CourierEvent{
+			Event: &deliverypb.CourierEvent_OrderStatusChanged{OrderStatusChanged: changed},
+		}, true
+	default:
+		return nil, false
+	}
+}
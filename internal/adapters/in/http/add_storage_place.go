@@ -0,0 +1,45 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s Server) AddStoragePlace(ctx echo.Context, courierId uuid.UUID) error {
+	var sp servers.NewStoragePlace
+	if err := ctx.Bind(&sp); err != nil {
+		return problems.NewBadRequest("invalid request body: " + err.Error())
+	}
+
+	maxWeight := 0
+	if sp.MaxWeight != nil {
+		maxWeight = *sp.MaxWeight
+	}
+
+	command, err := commands.NewAddStoragePlaceCommand(courierId, sp.Name, sp.TotalVolume, maxWeight)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.addStoragePlaceCommandHandler.Handle(ctx.Request().Context(), command)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+
+		if errors.Is(err, errs.ErrVersionIsInvalid) {
+			return problems.NewVersionConflict(err.Error())
+		}
+
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return ctx.NoContent(http.StatusCreated)
+}
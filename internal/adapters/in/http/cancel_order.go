@@ -0,0 +1,34 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s Server) CancelOrder(ctx echo.Context, orderId uuid.UUID) error {
+	command, err := commands.NewCancelOrderCommand(orderId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.cancelOrderCommandHandler.Handle(ctx.Request().Context(), command)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+
+		if errors.Is(err, errs.ErrVersionIsInvalid) {
+			return problems.NewVersionConflict(err.Error())
+		}
+
+		return problems.NewConflict(err.Error(), "/")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
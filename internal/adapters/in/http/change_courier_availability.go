@@ -0,0 +1,67 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s Server) StartCourierShift(ctx echo.Context, courierId uuid.UUID) error {
+	return s.changeCourierAvailability(ctx, courierId, commands.CourierAvailabilityActionStartShift)
+}
+
+func (s Server) EndCourierShift(ctx echo.Context, courierId uuid.UUID) error {
+	return s.changeCourierAvailability(ctx, courierId, commands.CourierAvailabilityActionEndShift)
+}
+
+func (s Server) StartCourierBreak(ctx echo.Context, courierId uuid.UUID) error {
+	return s.changeCourierAvailability(ctx, courierId, commands.CourierAvailabilityActionStartBreak)
+}
+
+func (s Server) EndCourierBreak(ctx echo.Context, courierId uuid.UUID) error {
+	return s.changeCourierAvailability(ctx, courierId, commands.CourierAvailabilityActionEndBreak)
+}
+
+func (s Server) changeCourierAvailability(
+	ctx echo.Context,
+	courierId uuid.UUID,
+	action commands.CourierAvailabilityAction) error {
+	command, err := commands.NewChangeCourierAvailabilityCommand(courierId, action)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	err = s.changeCourierAvailabilityCommandHandler.Handle(ctx.Request().Context(), command)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+
+		if errors.Is(err, errs.ErrVersionIsInvalid) {
+			return problems.NewVersionConflict(err.Error())
+		}
+
+		if isAvailabilityTransitionError(err) {
+			return problems.NewConflict(err.Error(), "/")
+		}
+
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// isAvailabilityTransitionError проверяет, что курьер не может перейти в запрошенное состояние
+// из текущего. Остальные ошибки не связаны с состоянием курьера и конфликтом не считаются
+func isAvailabilityTransitionError(err error) bool {
+	return errors.Is(err, courier.ErrCourierAlreadyOnShift) ||
+		errors.Is(err, courier.ErrCourierNotOnShift) ||
+		errors.Is(err, courier.ErrCourierNotOnBreak) ||
+		errors.Is(err, courier.ErrCourierHasOrders)
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"delivery/internal/adapters/in/http/problems"
 	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
 	"errors"
@@ -17,7 +18,21 @@ func (s Server) CreateCourier(ctx echo.Context) error {
 		return problems.NewBadRequest("invalid request body: " + err.Error())
 	}
 
-	command, err := commands.NewCreateCourierCommand(c.Name, c.Speed)
+	vehicle := courier.VehicleFoot
+	if c.VehicleType != nil {
+		parsed, err := courier.ParseVehicleType(string(*c.VehicleType))
+		if err != nil {
+			return problems.NewBadRequest(err.Error())
+		}
+		vehicle = parsed
+	}
+
+	speed := 0
+	if c.Speed != nil {
+		speed = *c.Speed
+	}
+
+	command, err := commands.NewCreateCourierCommand(c.Name, vehicle, speed)
 	if err != nil {
 		return problems.NewBadRequest(err.Error())
 	}
@@ -3,28 +3,107 @@ package http
 import (
 	"delivery/internal/adapters/in/http/problems"
 	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
 	"errors"
 	"net/http"
 
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
 func (s Server) CreateOrder(ctx echo.Context) error {
-	createOrderCommand, err := commands.NewCreateOrderCommand(uuid.New(), "Несуществующая", 5)
+	var o servers.NewOrder
+	if err := ctx.Bind(&o); err != nil {
+		return problems.NewBadRequest("invalid request body: " + err.Error())
+	}
+
+	terms, err := toOrderTerms(o)
 	if err != nil {
 		return problems.NewBadRequest(err.Error())
 	}
 
-	err = s.createOrderCommandHandler.Handle(ctx.Request().Context(), createOrderCommand)
+	createOrderCommand, err := commands.NewCreateOrderCommand(o.Id, o.Street, o.Volume, toOrderDetails(o), terms)
 	if err != nil {
-		if errors.Is(err, errs.ErrObjectNotFound) {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	response, err := s.createOrderCommandHandler.Handle(ctx.Request().Context(), createOrderCommand)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
 			return problems.NewNotFound(err.Error())
+		case isValidationError(err):
+			return problems.NewBadRequest(err.Error())
+		case errors.Is(err, commands.ErrOrderAlreadyExists):
+			return problems.NewConflict(err.Error(), "/")
+		default:
+			return problems.NewInternalServerError(err.Error())
 		}
+	}
 
-		return problems.NewConflict(err.Error(), "/")
+	httpResponse := servers.Order{
+		Id: response.OrderID,
+		Location: servers.Location{
+			X: response.Location.X(),
+			Y: response.Location.Y(),
+		},
 	}
 
-	return ctx.JSON(http.StatusCreated, nil)
+	// Повторный запрос с тем же идентификатором возвращает уже созданный заказ
+	if !response.Created {
+		return ctx.JSON(http.StatusOK, httpResponse)
+	}
+
+	return ctx.JSON(http.StatusCreated, httpResponse)
+}
+
+func toOrderDetails(o servers.NewOrder) commands.OrderDetails {
+	var details commands.OrderDetails
+	if o.Weight != nil {
+		details.Weight = *o.Weight
+	}
+
+	if o.Dimensions != nil {
+		details.Length = o.Dimensions.Length
+		details.Width = o.Dimensions.Width
+		details.Height = o.Dimensions.Height
+	}
+
+	if o.Items != nil {
+		details.Items = make([]commands.OrderItem, 0, len(*o.Items))
+		for _, item := range *o.Items {
+			details.Items = append(details.Items, commands.OrderItem{
+				GoodID:   item.GoodId,
+				Title:    item.Title,
+				Quantity: item.Quantity,
+			})
+		}
+	}
+
+	return details
+}
+
+func toOrderTerms(o servers.NewOrder) (commands.OrderTerms, error) {
+	var terms commands.OrderTerms
+	if o.Priority != nil {
+		priority, err := order.ParsePriority(string(*o.Priority))
+		if err != nil {
+			return commands.OrderTerms{}, err
+		}
+		terms.Priority = priority
+	}
+
+	if o.DeliveryWindow != nil {
+		terms.DeliveryFrom = o.DeliveryWindow.From
+		terms.DeliveryTo = o.DeliveryWindow.To
+	}
+
+	return terms, nil
+}
+
+func isValidationError(err error) bool {
+	return errors.Is(err, errs.ErrValueIsInvalid) ||
+		errors.Is(err, errs.ErrValueIsRequired) ||
+		errors.Is(err, errs.ErrValueIsOutOfRange)
 }
@@ -0,0 +1,28 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// problem - ошибка обработчика, которая сама описывает ответ в формате ProblemDetails
+type problem interface {
+	error
+	WriteResponse(w http.ResponseWriter)
+}
+
+// NewErrorHandler отдает ошибки обработчиков в формате ProblemDetails с их статусом.
+// Остальные ошибки передаются обработчику next
+func NewErrorHandler(next echo.HTTPErrorHandler) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		var p problem
+		if c.Response().Committed || !errors.As(err, &p) {
+			next(err, c)
+			return
+		}
+
+		p.WriteResponse(c.Response())
+	}
+}
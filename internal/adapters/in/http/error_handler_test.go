@@ -0,0 +1,86 @@
+package http
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorHandler(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantType   string
+	}{
+		{
+			name:       "Lost update is a version conflict",
+			err:        errs.NewVersionIsInvalidError("order", nil),
+			wantStatus: http.StatusConflict,
+			wantType:   "version-conflict",
+		},
+		{
+			name:       "Missing order is not found",
+			err:        errs.NewObjectNotFoundError("order", uuid.New()),
+			wantStatus: http.StatusNotFound,
+			wantType:   "not-found",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.HTTPErrorHandler = NewErrorHandler(e.DefaultHTTPErrorHandler)
+			servers.RegisterHandlers(e, Server{cancelOrderCommandHandler: fakeCancelOrderCommandHandler{err: tt.err}})
+
+			request := httptest.NewRequest(http.MethodPost, "/api/v1/orders/"+uuid.NewString()+"/cancel", nil)
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			assert.Equal(t, "application/problem+json", recorder.Header().Get(echo.HeaderContentType))
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+			assert.Equal(t, tt.wantType, body["type"])
+			assert.EqualValues(t, tt.wantStatus, body["status"])
+		})
+	}
+}
+
+func TestErrorHandler_PassesOtherErrorsToNext(t *testing.T) {
+	e := echo.New()
+	var got error
+	e.HTTPErrorHandler = NewErrorHandler(func(err error, c echo.Context) {
+		got = err
+		_ = c.NoContent(http.StatusTeapot)
+	})
+	e.GET("/", func(c echo.Context) error {
+		return errors.New("boom")
+	})
+
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusTeapot, recorder.Code)
+	assert.EqualError(t, got, "boom")
+}
+
+type fakeCancelOrderCommandHandler struct {
+	err error
+}
+
+func (h fakeCancelOrderCommandHandler) Handle(context.Context, commands.CancelOrderCommand) error {
+	return h.err
+}
@@ -0,0 +1,73 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s Server) GetCourier(ctx echo.Context, courierId uuid.UUID) error {
+	query, err := queries.NewGetCourierQuery(courierId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	queryResponse, err := s.getCourierQueryHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+
+		return err
+	}
+
+	storagePlaces := make([]servers.StoragePlace, 0, len(queryResponse.StoragePlaces))
+	for _, place := range queryResponse.StoragePlaces {
+		storagePlaces = append(storagePlaces, servers.StoragePlace{
+			Id:          place.ID,
+			Name:        place.Name,
+			TotalVolume: place.TotalVolume,
+			UsedVolume:  place.UsedVolume,
+			MaxWeight:   place.MaxWeight,
+			OrderId:     place.OrderID,
+		})
+	}
+
+	httpResponse := servers.CourierDetails{
+		Id:   queryResponse.ID,
+		Name: queryResponse.Name,
+		Location: servers.Location{
+			X: queryResponse.Location.X,
+			Y: queryResponse.Location.Y,
+		},
+		VehicleType:   servers.VehicleType(queryResponse.VehicleType),
+		Availability:  servers.CourierAvailability(queryResponse.Availability),
+		Speed:         queryResponse.Speed,
+		StoragePlaces: storagePlaces,
+	}
+
+	if target := queryResponse.Target; target != nil {
+		action := servers.Delivery
+		if target.IsPickup {
+			action = servers.Pickup
+		}
+
+		httpResponse.Target = &servers.CourierTarget{
+			OrderId: target.OrderID,
+			Location: servers.Location{
+				X: target.Location.X,
+				Y: target.Location.Y,
+			},
+			Action:             action,
+			EstimatedArrivalAt: target.EstimatedArrivalAt,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, httpResponse)
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"delivery/internal/adapters/in/http/problems"
 	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
 	"errors"
@@ -11,17 +12,25 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-func (s Server) GetCouriers(ctx echo.Context) error {
-	query, err := queries.NewGetAllCouriersQuery()
+func (s Server) GetCouriers(ctx echo.Context, params servers.GetCouriersParams) error {
+	filter := queries.CouriersFilter{
+		Busy:           params.Busy,
+		Availabilities: toAvailabilities(params.Availability),
+		Area:           queries.Area{MinX: params.MinX, MinY: params.MinY, MaxX: params.MaxX, MaxY: params.MaxY},
+	}
+
+	query, err := queries.NewGetAllCouriersQuery(filter, toPageRequest(params.Cursor, params.Limit, (*string)(params.Sort)))
 	if err != nil {
 		return problems.NewBadRequest(err.Error())
 	}
 
 	queryResponse, err := s.getAllCouriersQueryHandler.Handle(query)
 	if err != nil {
-		if errors.Is(err, errs.ErrObjectNotFound) {
-			return problems.NewNotFound(err.Error())
+		if errors.Is(err, errs.ErrValueIsInvalid) {
+			return problems.NewBadRequest(err.Error())
 		}
+
+		return problems.NewInternalServerError(err.Error())
 	}
 
 	var httpResponse = make([]servers.Courier, 0, len(queryResponse.Couriers))
@@ -32,13 +41,29 @@ func (s Server) GetCouriers(ctx echo.Context) error {
 		}
 
 		var sCourier = servers.Courier{
-			Id:       courier.ID,
-			Name:     courier.Name,
-			Location: location,
+			Id:           courier.ID,
+			Name:         courier.Name,
+			Location:     location,
+			VehicleType:  servers.VehicleType(courier.VehicleType),
+			Availability: servers.CourierAvailability(courier.Availability),
 		}
 
 		httpResponse = append(httpResponse, sCourier)
 	}
 
+	setNextCursor(ctx, queryResponse.NextCursor)
 	return ctx.JSON(http.StatusOK, httpResponse)
 }
+
+func toAvailabilities(values *[]servers.CourierAvailability) []courier.Availability {
+	if values == nil {
+		return nil
+	}
+
+	availabilities := make([]courier.Availability, 0, len(*values))
+	for _, value := range *values {
+		availabilities = append(availabilities, courier.Availability(value))
+	}
+
+	return availabilities
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (s Server) GetOrder(ctx echo.Context, orderId uuid.UUID) error {
+	query, err := queries.NewGetOrderQuery(orderId)
+	if err != nil {
+		return problems.NewBadRequest(err.Error())
+	}
+
+	queryResponse, err := s.getOrderQueryHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return problems.NewNotFound(err.Error())
+		}
+
+		return err
+	}
+
+	history := make([]servers.OrderStatusChange, 0, len(queryResponse.History))
+	for _, change := range queryResponse.History {
+		history = append(history, servers.OrderStatusChange{
+			Status:    servers.OrderStatus(change.Status),
+			ChangedAt: change.ChangedAt,
+		})
+	}
+
+	httpResponse := servers.OrderDetails{
+		Id:        queryResponse.ID,
+		Status:    servers.OrderStatus(queryResponse.Status),
+		Volume:    queryResponse.Volume,
+		CourierId: queryResponse.CourierID,
+		Location: servers.Location{
+			X: queryResponse.Location.X,
+			Y: queryResponse.Location.Y,
+		},
+		History: history,
+	}
+
+	return ctx.JSON(http.StatusOK, httpResponse)
+}
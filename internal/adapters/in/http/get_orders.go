@@ -3,6 +3,7 @@ package http
 import (
 	"delivery/internal/adapters/in/http/problems"
 	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/generated/servers"
 	"delivery/internal/pkg/errs"
 	"errors"
@@ -11,33 +12,64 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-func (s Server) GetOrders(ctx echo.Context) error {
-	query, err := queries.NewGetNotCompletedOrdersQuery()
+func (s Server) GetOrders(ctx echo.Context, params servers.GetOrdersParams) error {
+	filter := queries.OrdersFilter{
+		NotCompleted: true,
+		CourierID:    params.CourierId,
+		Area:         queries.Area{MinX: params.MinX, MinY: params.MinY, MaxX: params.MaxX, MaxY: params.MaxY},
+	}
+
+	return s.getOrders(ctx, filter, toPageRequest(params.Cursor, params.Limit, (*string)(params.Sort)))
+}
+
+func (s Server) ListOrders(ctx echo.Context, params servers.ListOrdersParams) error {
+	filter := queries.OrdersFilter{
+		CourierID: params.CourierId,
+		Area:      queries.Area{MinX: params.MinX, MinY: params.MinY, MaxX: params.MaxX, MaxY: params.MaxY},
+	}
+
+	if params.Status != nil {
+		for _, status := range *params.Status {
+			filter.Statuses = append(filter.Statuses, order.Status(status))
+		}
+	}
+
+	return s.getOrders(ctx, filter, toPageRequest(params.Cursor, params.Limit, (*string)(params.Sort)))
+}
+
+func (s Server) getOrders(ctx echo.Context, filter queries.OrdersFilter, page queries.PageRequest) error {
+	query, err := queries.NewGetOrdersQuery(filter, page)
 	if err != nil {
 		return problems.NewBadRequest(err.Error())
 	}
 
-	queryResponse, err := s.getNotCompletedOrdersQueryHandler.Handle(query)
+	queryResponse, err := s.getOrdersQueryHandler.Handle(query)
 	if err != nil {
-		if errors.Is(err, errs.ErrObjectNotFound) {
-			return problems.NewNotFound(err.Error())
+		if errors.Is(err, errs.ErrValueIsInvalid) {
+			return problems.NewBadRequest(err.Error())
 		}
+
+		return problems.NewInternalServerError(err.Error())
 	}
 
 	var httpResponse = make([]servers.Order, 0, len(queryResponse.Orders))
-	for _, courier := range queryResponse.Orders {
+	for _, o := range queryResponse.Orders {
 		location := servers.Location{
-			X: courier.Location.X,
-			Y: courier.Location.Y,
+			X: o.Location.X,
+			Y: o.Location.Y,
 		}
 
-		var sCourier = servers.Order{
-			Id:       courier.ID,
-			Location: location,
+		status := servers.OrderStatus(o.Status)
+		var sOrder = servers.Order{
+			Id:        o.ID,
+			Location:  location,
+			Status:    &status,
+			CourierId: o.CourierID,
 		}
 
-		httpResponse = append(httpResponse, sCourier)
+		httpResponse = append(httpResponse, sOrder)
 	}
 
+	setNextCursor(ctx, queryResponse.NextCursor)
 	return ctx.JSON(http.StatusOK, httpResponse)
 }
@@ -0,0 +1,33 @@
+package http
+
+import (
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/labstack/echo/v4"
+)
+
+// nextCursorHeader - заголовок ответа с курсором следующей страницы списка
+const nextCursorHeader = "X-Next-Cursor"
+
+func toPageRequest(cursor *string, limit *int, sort *string) queries.PageRequest {
+	var request queries.PageRequest
+	if cursor != nil {
+		request.Cursor = *cursor
+	}
+
+	if limit != nil {
+		request.Limit = *limit
+	}
+
+	if sort != nil {
+		request.Sort = *sort
+	}
+
+	return request
+}
+
+func setNextCursor(ctx echo.Context, nextCursor string) {
+	if nextCursor != "" {
+		ctx.Response().Header().Set(nextCursorHeader, nextCursor)
+	}
+}
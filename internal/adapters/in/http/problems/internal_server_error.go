@@ -0,0 +1,31 @@
+package problems
+
+import (
+	"errors"
+	"net/http"
+)
+
+var InternalServerError = errors.New("internal server error")
+
+type InternalError struct {
+	ProblemDetails
+}
+
+func NewInternalServerError(detail string) *InternalError {
+	return &InternalError{
+		ProblemDetails: ProblemDetails{
+			Type:   "internal-server-error",
+			Title:  "Internal Server Error",
+			Status: http.StatusInternalServerError,
+			Detail: detail,
+		},
+	}
+}
+
+func (e *InternalError) Error() string {
+	return e.ProblemDetails.Error()
+}
+
+func (e *InternalError) Unwrap() error {
+	return InternalServerError
+}
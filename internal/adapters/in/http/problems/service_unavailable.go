@@ -0,0 +1,31 @@
+package problems
+
+import (
+	"errors"
+	"net/http"
+)
+
+var ServiceUnavailable = errors.New("service unavailable")
+
+type ServiceUnavailableError struct {
+	ProblemDetails
+}
+
+func NewServiceUnavailable(detail string) *ServiceUnavailableError {
+	return &ServiceUnavailableError{
+		ProblemDetails: ProblemDetails{
+			Type:   "service-unavailable",
+			Title:  "Service Unavailable",
+			Status: http.StatusServiceUnavailable,
+			Detail: detail,
+		},
+	}
+}
+
+func (e *ServiceUnavailableError) Error() string {
+	return e.ProblemDetails.Error()
+}
+
+func (e *ServiceUnavailableError) Unwrap() error {
+	return ServiceUnavailable
+}
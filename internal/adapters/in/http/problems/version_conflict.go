@@ -0,0 +1,7 @@
+package problems
+
+// NewVersionConflict сообщает, что ресурс был изменен параллельным запросом.
+// Клиент может перечитать ресурс и повторить запрос
+func NewVersionConflict(detail string) *ConflictError {
+	return NewConflict("version-conflict", detail)
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"delivery/internal/adapters/in/stream"
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/application/usecases/queries"
 	"delivery/internal/pkg/errs"
@@ -9,16 +10,30 @@ import (
 type Server struct {
 	createCourierCommandHandler commands.CreateCourierCommandHandler
 	createOrderCommandHandler   commands.CreateOrderCommandHandler
+	cancelOrderCommandHandler   commands.CancelOrderCommandHandler
 
-	getAllCouriersQueryHandler        queries.GetAllCouriersQueryHandler
-	getNotCompletedOrdersQueryHandler queries.GetNotCompletedOrdersQueryHandler
+	changeCourierAvailabilityCommandHandler commands.ChangeCourierAvailabilityCommandHandler
+	addStoragePlaceCommandHandler           commands.AddStoragePlaceCommandHandler
+
+	getAllCouriersQueryHandler queries.GetAllCouriersQueryHandler
+	getCourierQueryHandler     queries.GetCourierQueryHandler
+	getOrdersQueryHandler      queries.GetOrdersQueryHandler
+	getOrderQueryHandler       queries.GetOrderQueryHandler
+
+	courierStream *stream.Hub
 }
 
 func NewServer(
 	createCourierCommandHandler commands.CreateCourierCommandHandler,
 	createOrderCommandHandler commands.CreateOrderCommandHandler,
+	cancelOrderCommandHandler commands.CancelOrderCommandHandler,
+	changeCourierAvailabilityCommandHandler commands.ChangeCourierAvailabilityCommandHandler,
+	addStoragePlaceCommandHandler commands.AddStoragePlaceCommandHandler,
 	getAllCouriersQueryHandler queries.GetAllCouriersQueryHandler,
-	getNotCompletedOrdersQueryHandler queries.GetNotCompletedOrdersQueryHandler,
+	getCourierQueryHandler queries.GetCourierQueryHandler,
+	getOrdersQueryHandler queries.GetOrdersQueryHandler,
+	getOrderQueryHandler queries.GetOrderQueryHandler,
+	courierStream *stream.Hub,
 ) (*Server, error) {
 	if createCourierCommandHandler == nil {
 		return nil, errs.NewValueIsRequiredError("createCourierCommandHandler")
@@ -28,18 +43,51 @@ func NewServer(
 		return nil, errs.NewValueIsRequiredError("createOrderCommandHandler")
 	}
 
+	if cancelOrderCommandHandler == nil {
+		return nil, errs.NewValueIsRequiredError("cancelOrderCommandHandler")
+	}
+
+	if changeCourierAvailabilityCommandHandler == nil {
+		return nil, errs.NewValueIsRequiredError("changeCourierAvailabilityCommandHandler")
+	}
+
+	if addStoragePlaceCommandHandler == nil {
+		return nil, errs.NewValueIsRequiredError("addStoragePlaceCommandHandler")
+	}
+
 	if getAllCouriersQueryHandler == nil {
 		return nil, errs.NewValueIsRequiredError("getAllCouriersQueryHandler")
 	}
 
-	if getNotCompletedOrdersQueryHandler == nil {
-		return nil, errs.NewValueIsRequiredError("getNotCompletedOrdersQueryHandler")
+	if getCourierQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getCourierQueryHandler")
+	}
+
+	if getOrdersQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getOrdersQueryHandler")
+	}
+
+	if getOrderQueryHandler == nil {
+		return nil, errs.NewValueIsRequiredError("getOrderQueryHandler")
+	}
+
+	if courierStream == nil {
+		return nil, errs.NewValueIsRequiredError("courierStream")
 	}
 
 	return &Server{
-		createCourierCommandHandler:       createCourierCommandHandler,
-		createOrderCommandHandler:         createOrderCommandHandler,
-		getAllCouriersQueryHandler:        getAllCouriersQueryHandler,
-		getNotCompletedOrdersQueryHandler: getNotCompletedOrdersQueryHandler,
+		createCourierCommandHandler: createCourierCommandHandler,
+		createOrderCommandHandler:   createOrderCommandHandler,
+		cancelOrderCommandHandler:   cancelOrderCommandHandler,
+
+		changeCourierAvailabilityCommandHandler: changeCourierAvailabilityCommandHandler,
+		addStoragePlaceCommandHandler:           addStoragePlaceCommandHandler,
+
+		getAllCouriersQueryHandler: getAllCouriersQueryHandler,
+		getCourierQueryHandler:     getCourierQueryHandler,
+		getOrdersQueryHandler:      getOrdersQueryHandler,
+		getOrderQueryHandler:       getOrderQueryHandler,
+
+		courierStream: courierStream,
 	}, nil
 }
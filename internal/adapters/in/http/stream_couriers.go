@@ -0,0 +1,67 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/http/problems"
+	"delivery/internal/generated/servers"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// streamKeepAliveInterval - как часто в тихий поток отправляется комментарий,
+// чтобы прокси не закрывали соединение
+const streamKeepAliveInterval = 15 * time.Second
+
+func (s Server) StreamCouriers(ctx echo.Context, params servers.StreamCouriersParams) error {
+	var courierIDs []uuid.UUID
+	if params.CourierId != nil {
+		courierIDs = *params.CourierId
+	}
+
+	subscription, err := s.courierStream.Subscribe(courierIDs)
+	if err != nil {
+		// поток закрывается только при остановке приложения
+		return problems.NewServiceUnavailable(err.Error())
+	}
+	defer s.courierStream.Unsubscribe(subscription)
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case message, ok := <-subscription.Messages():
+			if !ok {
+				return nil
+			}
+
+			var data []byte
+			data, err = json.Marshal(message.Payload)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(response, "event: %s\ndata: %s\n\n", message.Event, data)
+		case <-keepAlive.C:
+			_, err = fmt.Fprint(response, ": keep-alive\n\n")
+		}
+
+		if err != nil {
+			return nil
+		}
+		response.Flush()
+	}
+}
@@ -0,0 +1,28 @@
+package http
+
+import (
+	"delivery/internal/adapters/in/stream"
+	"delivery/internal/generated/servers"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamCouriers_ClosedHubIsUnavailable(t *testing.T) {
+	hub := stream.NewHub()
+	require.NoError(t, hub.Close())
+
+	e := echo.New()
+	e.HTTPErrorHandler = NewErrorHandler(e.DefaultHTTPErrorHandler)
+	servers.RegisterHandlers(e, Server{courierStream: hub})
+
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/couriers/stream", nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, "application/problem+json", recorder.Header().Get(echo.HeaderContentType))
+}
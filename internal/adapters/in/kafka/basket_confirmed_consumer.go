@@ -0,0 +1,221 @@
+package kafka
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/generated/queues/basketconfirmedpb"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type BasketConfirmedConsumer interface {
+	Consume() error
+	Close() error
+}
+
+// Задержка перед повторной сессией после ошибки растет от минимальной до максимальной
+const (
+	consumeMinBackoff = 100 * time.Millisecond
+	consumeMaxBackoff = 30 * time.Second
+)
+
+var _ BasketConfirmedConsumer = &basketConfirmedConsumer{}
+var _ sarama.ConsumerGroupHandler = &basketConfirmedConsumer{}
+
+type basketConfirmedConsumer struct {
+	topic                     string
+	consumerGroup             sarama.ConsumerGroup
+	createOrderCommandHandler commands.CreateOrderCommandHandler
+	ctx                       context.Context
+	cancel                    context.CancelFunc
+
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+	handleFailed atomic.Bool
+}
+
+func NewBasketConfirmedConsumer(
+	brokers []string,
+	group string,
+	topic string,
+	createOrderCommandHandler commands.CreateOrderCommandHandler,
+) (BasketConfirmedConsumer, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+
+	if group == "" {
+		return nil, errs.NewValueIsRequiredError("group")
+	}
+
+	config := sarama.NewConfig()
+	config.Version = sarama.V3_4_0_0
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	// Смещения фиксируются вручную и только после успешной обработки сообщения
+	config.Consumer.Offsets.AutoCommit.Enable = false
+
+	consumerGroup, err := sarama.NewConsumerGroup(brokers, group, config)
+	if err != nil {
+		return nil, fmt.Errorf("create consumer group: %w", err)
+	}
+
+	consumer, err := newBasketConfirmedConsumer(consumerGroup, topic, createOrderCommandHandler)
+	if err != nil {
+		_ = consumerGroup.Close()
+		return nil, err
+	}
+
+	return consumer, nil
+}
+
+func newBasketConfirmedConsumer(
+	consumerGroup sarama.ConsumerGroup,
+	topic string,
+	createOrderCommandHandler commands.CreateOrderCommandHandler,
+) (*basketConfirmedConsumer, error) {
+	if consumerGroup == nil {
+		return nil, errs.NewValueIsRequiredError("consumerGroup")
+	}
+
+	if topic == "" {
+		return nil, errs.NewValueIsRequiredError("topic")
+	}
+
+	if createOrderCommandHandler == nil {
+		return nil, errs.NewValueIsRequiredError("createOrderCommandHandler")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &basketConfirmedConsumer{
+		topic:                     topic,
+		consumerGroup:             consumerGroup,
+		createOrderCommandHandler: createOrderCommandHandler,
+		ctx:                       ctx,
+		cancel:                    cancel,
+		minBackoff:                consumeMinBackoff,
+		maxBackoff:                consumeMaxBackoff,
+	}, nil
+}
+
+// Consume блокирует вызывающего до закрытия консьюмера.
+// После каждой ребалансировки (или ошибки обработки) сессия создаётся заново
+// и чтение продолжается с последнего зафиксированного смещения.
+// Пока обработка завершается ошибкой, новые сессии создаются с растущей задержкой,
+// чтобы недоступная БД не превращалась в непрерывную ребалансировку.
+func (c *basketConfirmedConsumer) Consume() error {
+	backoff := c.minBackoff
+	for {
+		err := c.consumerGroup.Consume(c.ctx, []string{c.topic}, c)
+		if err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return nil
+			}
+			log.Errorf("kafka consume error: %v", err)
+		}
+
+		if c.ctx.Err() != nil {
+			return nil
+		}
+
+		failed := c.handleFailed.Swap(false)
+		if err == nil && !failed {
+			backoff = c.minBackoff
+			continue
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-c.ctx.Done():
+			return nil
+		}
+		backoff = min(backoff*2, c.maxBackoff)
+	}
+}
+
+func (c *basketConfirmedConsumer) Close() error {
+	c.cancel()
+	return c.consumerGroup.Close()
+}
+
+func (c *basketConfirmedConsumer) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (c *basketConfirmedConsumer) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (c *basketConfirmedConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			err := c.handle(session.Context(), message)
+			if err != nil {
+				c.handleFailed.Store(true)
+				// Смещение не фиксируется: сессия завершается, и сообщение
+				// будет прочитано повторно в следующей сессии
+				return fmt.Errorf("handle message at offset %d: %w", message.Offset, err)
+			}
+
+			session.MarkMessage(message, "")
+			session.Commit()
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (c *basketConfirmedConsumer) handle(ctx context.Context, message *sarama.ConsumerMessage) error {
+	command, err := c.toCreateOrderCommand(message)
+	if err != nil {
+		// Некорректное сообщение не станет корректным при повторном чтении,
+		// поэтому оно пропускается, чтобы не блокировать партицию
+		log.Errorf("skip invalid basket confirmed message at offset %d: %v", message.Offset, err)
+		return nil
+	}
+
+	_, err = c.createOrderCommandHandler.Handle(ctx, command)
+	return err
+}
+
+func (c *basketConfirmedConsumer) toCreateOrderCommand(message *sarama.ConsumerMessage) (commands.CreateOrderCommand, error) {
+	var event basketconfirmedpb.BasketConfirmedIntegrationEvent
+	if err := protojson.Unmarshal(message.Value, &event); err != nil {
+		return commands.CreateOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("message", err)
+	}
+
+	basketID, err := uuid.Parse(event.GetBasketId())
+	if err != nil {
+		return commands.CreateOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("basketId", err)
+	}
+
+	items := make([]commands.OrderItem, 0, len(event.GetItems()))
+	for _, item := range event.GetItems() {
+		goodID, err := uuid.Parse(item.GetGoodId())
+		if err != nil {
+			return commands.CreateOrderCommand{}, errs.NewValueIsInvalidErrorWithCause("goodId", err)
+		}
+
+		items = append(items, commands.OrderItem{
+			GoodID:   goodID,
+			Title:    item.GetTitle(),
+			Quantity: int(item.GetQuantity()),
+		})
+	}
+
+	return commands.NewCreateOrderCommand(
+		basketID, event.GetAddress().GetStreet(), int(event.GetVolume()), commands.OrderDetails{Items: items}, commands.OrderTerms{})
+}
@@ -0,0 +1,294 @@
+package kafka
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/generated/queues/basketconfirmedpb"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const testTopic = "basket.confirmed"
+
+func TestBasketConfirmedConsumer_CreatesOrdersAndCommitsOffsets(t *testing.T) {
+	firstID, secondID := uuid.New(), uuid.New()
+	broker := newFakeBroker(
+		basketConfirmedMessage(t, firstID.String(), "Тверская", 3),
+		basketConfirmedMessage(t, secondID.String(), "Арбат", 7),
+	)
+	handler := &fakeCreateOrderCommandHandler{}
+
+	consumer, err := newBasketConfirmedConsumer(broker.consumerGroup(), testTopic, handler)
+	require.NoError(t, err)
+
+	err = broker.runSession(consumer)
+	require.NoError(t, err)
+
+	require.Len(t, handler.commands, 2)
+	assert.Equal(t, firstID, handler.commands[0].OrderID())
+	assert.Equal(t, "Тверская", handler.commands[0].Street())
+	assert.Equal(t, 3, handler.commands[0].Volume())
+	assert.Equal(t, secondID, handler.commands[1].OrderID())
+	assert.Equal(t, int64(2), broker.committedOffset())
+}
+
+func TestBasketConfirmedConsumer_KeepsItems(t *testing.T) {
+	goodID := uuid.New()
+	value, err := protojson.Marshal(&basketconfirmedpb.BasketConfirmedIntegrationEvent{
+		BasketId: uuid.NewString(),
+		Address:  &basketconfirmedpb.Address{Street: "Тверская"},
+		Volume:   3,
+		Items: []*basketconfirmedpb.Item{
+			{Id: uuid.NewString(), GoodId: goodID.String(), Title: "Кофе", Price: 350, Quantity: 2},
+		},
+	})
+	require.NoError(t, err)
+
+	broker := newFakeBroker(value)
+	handler := &fakeCreateOrderCommandHandler{}
+
+	consumer, err := newBasketConfirmedConsumer(broker.consumerGroup(), testTopic, handler)
+	require.NoError(t, err)
+	require.NoError(t, broker.runSession(consumer))
+
+	require.Len(t, handler.commands, 1)
+	assert.Equal(t,
+		[]commands.OrderItem{{GoodID: goodID, Title: "Кофе", Quantity: 2}},
+		handler.commands[0].Details().Items)
+}
+
+func TestBasketConfirmedConsumer_DoesNotCommitWhenHandlerFails(t *testing.T) {
+	broker := newFakeBroker(
+		basketConfirmedMessage(t, uuid.NewString(), "Тверская", 3),
+		basketConfirmedMessage(t, uuid.NewString(), "Арбат", 7),
+	)
+	handler := &fakeCreateOrderCommandHandler{errs: []error{nil, errors.New("db is down")}}
+
+	consumer, err := newBasketConfirmedConsumer(broker.consumerGroup(), testTopic, handler)
+	require.NoError(t, err)
+
+	err = broker.runSession(consumer)
+	require.Error(t, err)
+	assert.Equal(t, int64(1), broker.committedOffset())
+
+	// Следующая сессия перечитывает сообщение, которое не удалось обработать
+	err = broker.runSession(consumer)
+	require.NoError(t, err)
+	require.Len(t, handler.commands, 3)
+	assert.Equal(t, handler.commands[1].OrderID(), handler.commands[2].OrderID())
+	assert.Equal(t, int64(2), broker.committedOffset())
+}
+
+func TestBasketConfirmedConsumer_BacksOffWhileHandlerFails(t *testing.T) {
+	broker := newFakeBroker(basketConfirmedMessage(t, uuid.NewString(), "Тверская", 3))
+	handlerErrs := make([]error, 1000)
+	for i := range handlerErrs {
+		handlerErrs[i] = errors.New("db is down")
+	}
+	handler := &fakeCreateOrderCommandHandler{errs: handlerErrs}
+
+	consumer, err := newBasketConfirmedConsumer(broker.consumerGroup(), testTopic, handler)
+	require.NoError(t, err)
+	consumer.minBackoff = 20 * time.Millisecond
+
+	done := make(chan error)
+	go func() {
+		done <- consumer.Consume()
+	}()
+
+	time.Sleep(150 * time.Millisecond)
+	require.NoError(t, consumer.Close())
+	require.NoError(t, <-done)
+
+	// без задержки сессии перезапускались бы сотни раз: 0, 20, 60, 140 мс
+	assert.GreaterOrEqual(t, len(handler.commands), 2)
+	assert.LessOrEqual(t, len(handler.commands), 5)
+	assert.Equal(t, int64(0), broker.committedOffset())
+}
+
+func TestBasketConfirmedConsumer_SkipsInvalidMessages(t *testing.T) {
+	tests := []struct {
+		name  string
+		value []byte
+	}{
+		{
+			name:  "Not a json",
+			value: []byte("not a json"),
+		},
+		{
+			name:  "Invalid basket id",
+			value: basketConfirmedMessage(t, "not-a-uuid", "Тверская", 3),
+		},
+		{
+			name:  "Empty street",
+			value: basketConfirmedMessage(t, uuid.NewString(), "", 3),
+		},
+		{
+			name:  "Zero volume",
+			value: basketConfirmedMessage(t, uuid.NewString(), "Тверская", 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker := newFakeBroker(tt.value)
+			handler := &fakeCreateOrderCommandHandler{}
+
+			consumer, err := newBasketConfirmedConsumer(broker.consumerGroup(), testTopic, handler)
+			require.NoError(t, err)
+
+			err = broker.runSession(consumer)
+			require.NoError(t, err)
+
+			assert.Empty(t, handler.commands)
+			assert.Equal(t, int64(1), broker.committedOffset())
+		})
+	}
+}
+
+func TestNewBasketConfirmedConsumer_Errors(t *testing.T) {
+	broker := newFakeBroker()
+
+	_, err := newBasketConfirmedConsumer(nil, testTopic, &fakeCreateOrderCommandHandler{})
+	assert.Error(t, err)
+
+	_, err = newBasketConfirmedConsumer(broker.consumerGroup(), "", &fakeCreateOrderCommandHandler{})
+	assert.Error(t, err)
+
+	_, err = newBasketConfirmedConsumer(broker.consumerGroup(), testTopic, nil)
+	assert.Error(t, err)
+}
+
+func basketConfirmedMessage(t *testing.T, basketID string, street string, volume int32) []byte {
+	t.Helper()
+
+	value, err := protojson.Marshal(&basketconfirmedpb.BasketConfirmedIntegrationEvent{
+		BasketId: basketID,
+		Address:  &basketconfirmedpb.Address{Street: street},
+		Volume:   volume,
+	})
+	require.NoError(t, err)
+
+	return value
+}
+
+type fakeCreateOrderCommandHandler struct {
+	commands []commands.CreateOrderCommand
+	errs     []error
+}
+
+func (h *fakeCreateOrderCommandHandler) Handle(
+	_ context.Context,
+	command commands.CreateOrderCommand) (commands.CreateOrderResponse, error) {
+	h.commands = append(h.commands, command)
+
+	if len(h.errs) == 0 {
+		return commands.CreateOrderResponse{OrderID: command.OrderID(), Created: true}, nil
+	}
+
+	err := h.errs[0]
+	h.errs = h.errs[1:]
+	return commands.CreateOrderResponse{}, err
+}
+
+// fakeBroker - внутрипроцессная замена Kafka с одной партицией
+type fakeBroker struct {
+	mu        sync.Mutex
+	messages  []*sarama.ConsumerMessage
+	committed int64
+}
+
+func newFakeBroker(values ...[]byte) *fakeBroker {
+	b := &fakeBroker{}
+	for i, value := range values {
+		b.messages = append(b.messages, &sarama.ConsumerMessage{
+			Topic:  testTopic,
+			Offset: int64(i),
+			Value:  value,
+		})
+	}
+	return b
+}
+
+func (b *fakeBroker) consumerGroup() sarama.ConsumerGroup {
+	return &fakeConsumerGroup{broker: b}
+}
+
+func (b *fakeBroker) committedOffset() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.committed
+}
+
+// runSession выполняет одну сессию консьюмер-группы: все сообщения начиная
+// с зафиксированного смещения передаются обработчику
+func (b *fakeBroker) runSession(handler sarama.ConsumerGroupHandler) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	session := &fakeSession{ctx: ctx, broker: b, marked: b.committedOffset()}
+	claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage, len(b.messages))}
+	for _, message := range b.messages[b.committedOffset():] {
+		claim.messages <- message
+	}
+	close(claim.messages)
+
+	if err := handler.Setup(session); err != nil {
+		return err
+	}
+	err := handler.ConsumeClaim(session, claim)
+	if cleanupErr := handler.Cleanup(session); cleanupErr != nil {
+		return cleanupErr
+	}
+	return err
+}
+
+type fakeConsumerGroup struct {
+	sarama.ConsumerGroup
+	broker *fakeBroker
+}
+
+func (g *fakeConsumerGroup) Consume(_ context.Context, _ []string, handler sarama.ConsumerGroupHandler) error {
+	return g.broker.runSession(handler)
+}
+
+func (g *fakeConsumerGroup) Close() error {
+	return nil
+}
+
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	broker *fakeBroker
+	marked int64
+}
+
+func (s *fakeSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = msg.Offset + 1
+}
+
+func (s *fakeSession) Commit() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.committed = s.marked
+}
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
@@ -0,0 +1,226 @@
+package stream
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventCourierMoved       = "courier_moved"
+	EventOrderStatusChanged = "order_status_changed"
+)
+
+// ErrHubClosed возвращается при подписке на остановленный Hub
+var ErrHubClosed = errors.New("hub is closed")
+
+// subscriberBuffer - сколько сообщений может ждать подписчика, прежде чем его отключат
+const subscriberBuffer = 64
+
+// Events - доменные события, которые нужно передать в Hub
+var Events = []ddd.DomainEvent{
+	courier.CourierMovedDomainEvent{},
+	order.OrderCreatedDomainEvent{},
+	order.OrderAssignedDomainEvent{},
+	order.OrderPickedUpDomainEvent{},
+	order.OrderCompletedDomainEvent{},
+	order.OrderCanceledDomainEvent{},
+}
+
+// CourierMovedEvent и OrderStatusChangedEvent - данные событий потока. JSON совпадает
+// со схемами CourierMovedEvent и OrderStatusChangedEvent в api/openapi/openapi.yml
+type CourierMovedEvent struct {
+	CourierID  uuid.UUID `json:"courierId"`
+	Location   Location  `json:"location"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+type OrderStatusChangedEvent struct {
+	OrderID    uuid.UUID    `json:"orderId"`
+	CourierID  *uuid.UUID   `json:"courierId,omitempty"`
+	Status     order.Status `json:"status"`
+	OccurredAt time.Time    `json:"occurredAt"`
+}
+
+type Location struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Message - событие потока: имя и данные, CourierMovedEvent или OrderStatusChangedEvent.
+// Каждый транспорт сам переводит данные в свой формат
+type Message struct {
+	Event   string
+	Payload any
+
+	courierID uuid.UUID
+}
+
+type Subscription struct {
+	courierIDs []uuid.UUID
+	messages   chan Message
+}
+
+// Messages возвращает канал сообщений подписки. Канал закрывается, когда подписчик отключен
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+func (s *Subscription) accepts(m Message) bool {
+	return len(s.courierIDs) == 0 || slices.Contains(s.courierIDs, m.courierID)
+}
+
+var _ ddd.EventHandler = &Hub{}
+
+// Hub рассылает подписчикам перемещения курьеров и смену статусов заказов.
+// Подписывается на доменные события через Subscribe, то есть получает их после фиксации транзакции.
+// Рассылка не блокируется: подписчик, который не успевает читать, отключается
+type Hub struct {
+	mu            sync.Mutex
+	subscriptions map[*Subscription]struct{}
+	closed        bool
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subscriptions: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe подписывает на события курьеров courierIDs, пустой список - на события всех курьеров
+func (h *Hub) Subscribe(courierIDs []uuid.UUID) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, ErrHubClosed
+	}
+
+	s := &Subscription{
+		courierIDs: slices.Clone(courierIDs),
+		messages:   make(chan Message, subscriberBuffer),
+	}
+	h.subscriptions[s] = struct{}{}
+
+	return s, nil
+}
+
+func (h *Hub) Unsubscribe(s *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.drop(s)
+}
+
+func (h *Hub) Handle(_ context.Context, domainEvent ddd.DomainEvent) error {
+	if domainEvent == nil {
+		return errs.NewValueIsRequiredError("domainEvent")
+	}
+
+	message, ok := toMessage(domainEvent)
+	if !ok {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subscriptions {
+		if !s.accepts(message) {
+			continue
+		}
+
+		select {
+		case s.messages <- message:
+		default:
+			h.drop(s)
+		}
+	}
+
+	return nil
+}
+
+// Close отключает всех подписчиков
+func (h *Hub) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subscriptions {
+		h.drop(s)
+	}
+	h.closed = true
+
+	return nil
+}
+
+func (h *Hub) drop(s *Subscription) {
+	if _, ok := h.subscriptions[s]; !ok {
+		return
+	}
+
+	delete(h.subscriptions, s)
+	close(s.messages)
+}
+
+func toMessage(domainEvent ddd.DomainEvent) (Message, bool) {
+	var (
+		name      string
+		courierID uuid.UUID
+		data      any
+	)
+
+	switch e := domainEvent.(type) {
+	case courier.CourierMovedDomainEvent:
+		name, courierID = EventCourierMoved, e.CourierID
+		data = CourierMovedEvent{
+			CourierID:  e.CourierID,
+			Location:   Location{X: e.ToX, Y: e.ToY},
+			OccurredAt: e.OccurredAt,
+		}
+	case order.OrderCreatedDomainEvent:
+		name = EventOrderStatusChanged
+		data = newOrderStatusChangedEvent(e.OrderID, courierID, e.Status, e.BaseDomainEvent)
+	case order.OrderAssignedDomainEvent:
+		name, courierID = EventOrderStatusChanged, e.CourierID
+		data = newOrderStatusChangedEvent(e.OrderID, courierID, e.Status, e.BaseDomainEvent)
+	case order.OrderPickedUpDomainEvent:
+		name, courierID = EventOrderStatusChanged, e.CourierID
+		data = newOrderStatusChangedEvent(e.OrderID, courierID, e.Status, e.BaseDomainEvent)
+	case order.OrderCompletedDomainEvent:
+		name, courierID = EventOrderStatusChanged, e.CourierID
+		data = newOrderStatusChangedEvent(e.OrderID, courierID, e.Status, e.BaseDomainEvent)
+	case order.OrderCanceledDomainEvent:
+		name, courierID = EventOrderStatusChanged, e.CourierID
+		data = newOrderStatusChangedEvent(e.OrderID, courierID, e.Status, e.BaseDomainEvent)
+	default:
+		return Message{}, false
+	}
+
+	return Message{Event: name, Payload: data, courierID: courierID}, true
+}
+
+func newOrderStatusChangedEvent(
+	orderID uuid.UUID,
+	courierID uuid.UUID,
+	status order.Status,
+	base ddd.BaseDomainEvent) OrderStatusChangedEvent {
+	event := OrderStatusChangedEvent{
+		OrderID:    orderID,
+		Status:     status,
+		OccurredAt: base.OccurredAt,
+	}
+
+	if courierID != uuid.Nil {
+		event.CourierID = &courierID
+	}
+
+	return event
+}
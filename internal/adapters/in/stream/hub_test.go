@@ -0,0 +1,123 @@
+package stream_test
+
+import (
+	"context"
+	"delivery/internal/adapters/in/stream"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tests"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_FiltersByCourier(t *testing.T) {
+	hub := stream.NewHub()
+
+	alice := tests.CreateCourier("Alice", 1, tests.CreateLocation(1, 1))
+	bob := tests.CreateCourier("Bob", 1, tests.CreateLocation(5, 5))
+
+	all, err := hub.Subscribe(nil)
+	require.NoError(t, err)
+	onlyAlice, err := hub.Subscribe([]uuid.UUID{alice.Id()})
+	require.NoError(t, err)
+
+	for _, c := range []*courier.Courier{alice, bob} {
+		require.NoError(t, hub.Handle(context.Background(), movedEvent(t, c)))
+	}
+
+	assert.Len(t, all.Messages(), 2)
+	require.Len(t, onlyAlice.Messages(), 1)
+
+	message := <-onlyAlice.Messages()
+	assert.Equal(t, stream.EventCourierMoved, message.Event)
+	require.IsType(t, stream.CourierMovedEvent{}, message.Payload)
+
+	moved := message.Payload.(stream.CourierMovedEvent)
+	assert.Equal(t, alice.Id(), moved.CourierID)
+	assert.Equal(t, stream.Location{X: 1, Y: 1}, moved.Location)
+
+	// JSON события совпадает со схемой потока в OpenAPI
+	data, err := json.Marshal(message.Payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, fmt.Sprintf(`{"courierId":%q,"location":{"x":1,"y":1},"occurredAt":%q}`,
+		alice.Id(), moved.OccurredAt.Format(time.RFC3339Nano)), string(data))
+}
+
+func TestHub_SendsOrderStatusChanges(t *testing.T) {
+	hub := stream.NewHub()
+
+	courierID := uuid.New()
+	filtered, err := hub.Subscribe([]uuid.UUID{courierID})
+	require.NoError(t, err)
+
+	o := tests.CreateOrder(uuid.New(), tests.CreateLocation(3, 3), 5)
+	require.NoError(t, o.Assign(courierID))
+	for _, event := range o.GetDomainEvents() {
+		require.NoError(t, hub.Handle(context.Background(), event))
+	}
+
+	// событие создания заказа не относится к курьеру
+	require.Len(t, filtered.Messages(), 1)
+
+	message := <-filtered.Messages()
+	assert.Equal(t, stream.EventOrderStatusChanged, message.Event)
+
+	require.IsType(t, stream.OrderStatusChangedEvent{}, message.Payload)
+
+	changed := message.Payload.(stream.OrderStatusChangedEvent)
+	assert.Equal(t, o.ID(), changed.OrderID)
+	assert.Equal(t, &courierID, changed.CourierID)
+	assert.Equal(t, order.StatusAssigned, changed.Status)
+}
+
+func TestHub_DropsSlowSubscriber(t *testing.T) {
+	hub := stream.NewHub()
+
+	c := tests.CreateCourier("Alice", 1, tests.CreateLocation(1, 1))
+	slow, err := hub.Subscribe(nil)
+	require.NoError(t, err)
+
+	// переполнение буфера не блокирует рассылку, а отключает подписчика
+	for range 100 {
+		require.NoError(t, hub.Handle(context.Background(), movedEvent(t, c)))
+	}
+
+	received := 0
+	for range slow.Messages() {
+		received++
+	}
+	assert.Less(t, received, 100)
+
+	hub.Unsubscribe(slow)
+}
+
+func TestHub_Close(t *testing.T) {
+	hub := stream.NewHub()
+
+	subscription, err := hub.Subscribe(nil)
+	require.NoError(t, err)
+
+	require.NoError(t, hub.Close())
+
+	_, ok := <-subscription.Messages()
+	assert.False(t, ok)
+
+	_, err = hub.Subscribe(nil)
+	assert.ErrorIs(t, err, stream.ErrHubClosed)
+}
+
+func movedEvent(t *testing.T, c *courier.Courier) courier.CourierMovedDomainEvent {
+	t.Helper()
+
+	from, err := kernel.NewLocation(1, 1)
+	require.NoError(t, err)
+
+	return courier.NewCourierMovedDomainEvent(c, from)
+}
@@ -0,0 +1,132 @@
+package kafka
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/generated/queues/orderstatuschangedpb"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// orderStatusChangedEvent - доменное событие, меняющее статус заказа.
+// Только такие события публикуются в топик изменения статуса заказа.
+type orderStatusChangedEvent interface {
+	ddd.DomainEvent
+	GetOrderID() uuid.UUID
+	GetOrderStatus() order.Status
+}
+
+var (
+	_ orderStatusChangedEvent = order.OrderCreatedDomainEvent{}
+	_ orderStatusChangedEvent = order.OrderAssignedDomainEvent{}
+	_ orderStatusChangedEvent = order.OrderPickedUpDomainEvent{}
+	_ orderStatusChangedEvent = order.OrderCompletedDomainEvent{}
+	_ orderStatusChangedEvent = order.OrderCanceledDomainEvent{}
+)
+
+var _ ports.OrderProducer = &orderProducer{}
+
+type orderProducer struct {
+	topic    string
+	producer sarama.SyncProducer
+}
+
+func NewOrderProducer(brokers []string, topic string) (ports.OrderProducer, error) {
+	if len(brokers) == 0 {
+		return nil, errs.NewValueIsRequiredError("brokers")
+	}
+
+	config := sarama.NewConfig()
+	config.Version = sarama.V3_4_0_0
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Producer.Idempotent = true
+	config.Net.MaxOpenRequests = 1
+
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("create sync producer: %w", err)
+	}
+
+	return newOrderProducer(producer, topic)
+}
+
+func newOrderProducer(producer sarama.SyncProducer, topic string) (*orderProducer, error) {
+	if producer == nil {
+		return nil, errs.NewValueIsRequiredError("producer")
+	}
+
+	if topic == "" {
+		return nil, errs.NewValueIsRequiredError("topic")
+	}
+
+	return &orderProducer{
+		topic:    topic,
+		producer: producer,
+	}, nil
+}
+
+func (p *orderProducer) Publish(_ context.Context, domainEvent ddd.DomainEvent) error {
+	if domainEvent == nil {
+		return errs.NewValueIsRequiredError("domainEvent")
+	}
+
+	event, ok := domainEvent.(orderStatusChangedEvent)
+	if !ok {
+		return nil
+	}
+
+	integrationEvent := &orderstatuschangedpb.OrderStatusChangedIntegrationEvent{
+		OrderId:     event.GetOrderID().String(),
+		OrderStatus: toIntegrationStatus(event.GetOrderStatus()),
+	}
+
+	value, err := protojson.Marshal(integrationEvent)
+	if err != nil {
+		return fmt.Errorf("marshal integration event: %w", err)
+	}
+
+	message := &sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder(event.GetOrderID().String()),
+		Value: sarama.ByteEncoder(value),
+		Headers: []sarama.RecordHeader{
+			// Позволяет получателю отбрасывать дубликаты при повторной отправке
+			{Key: []byte("message-id"), Value: []byte(event.GetID().String())},
+		},
+	}
+
+	_, _, err = p.producer.SendMessage(message)
+	if err != nil {
+		return fmt.Errorf("send message: %w", err)
+	}
+
+	return nil
+}
+
+func (p *orderProducer) Close() error {
+	return p.producer.Close()
+}
+
+func toIntegrationStatus(status order.Status) orderstatuschangedpb.OrderStatus {
+	switch status {
+	case order.StatusCreated:
+		return orderstatuschangedpb.OrderStatus_Created
+	case order.StatusAssigned:
+		return orderstatuschangedpb.OrderStatus_Assigned
+	case order.StatusPickedUp:
+		return orderstatuschangedpb.OrderStatus_PickedUp
+	case order.StatusCompleted:
+		return orderstatuschangedpb.OrderStatus_Completed
+	case order.StatusCanceled:
+		return orderstatuschangedpb.OrderStatus_Canceled
+	default:
+		return orderstatuschangedpb.OrderStatus_None
+	}
+}
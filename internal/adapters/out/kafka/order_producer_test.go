@@ -0,0 +1,143 @@
+package kafka
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/generated/queues/orderstatuschangedpb"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const testTopic = "order.status.changed"
+
+func TestOrderProducer_PublishOrderStatusChangedEvent(t *testing.T) {
+	tests := []struct {
+		name   string
+		status order.Status
+		want   orderstatuschangedpb.OrderStatus
+	}{
+		{
+			name:   "Created",
+			status: order.StatusCreated,
+			want:   orderstatuschangedpb.OrderStatus_Created,
+		},
+		{
+			name:   "Assigned",
+			status: order.StatusAssigned,
+			want:   orderstatuschangedpb.OrderStatus_Assigned,
+		},
+		{
+			name:   "PickedUp",
+			status: order.StatusPickedUp,
+			want:   orderstatuschangedpb.OrderStatus_PickedUp,
+		},
+		{
+			name:   "Completed",
+			status: order.StatusCompleted,
+			want:   orderstatuschangedpb.OrderStatus_Completed,
+		},
+		{
+			name:   "Canceled",
+			status: order.StatusCanceled,
+			want:   orderstatuschangedpb.OrderStatus_Canceled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := testOrderStatusChangedEvent{ID: uuid.New(), OrderID: uuid.New(), Status: tt.status}
+
+			syncProducer := mocks.NewSyncProducer(t, nil)
+			syncProducer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+				assert.Equal(t, testTopic, msg.Topic)
+
+				key, err := msg.Key.Encode()
+				require.NoError(t, err)
+				assert.Equal(t, event.OrderID.String(), string(key))
+
+				value, err := msg.Value.Encode()
+				require.NoError(t, err)
+
+				var integrationEvent orderstatuschangedpb.OrderStatusChangedIntegrationEvent
+				require.NoError(t, protojson.Unmarshal(value, &integrationEvent))
+				assert.Equal(t, event.OrderID.String(), integrationEvent.GetOrderId())
+				assert.Equal(t, tt.want, integrationEvent.GetOrderStatus())
+
+				require.Len(t, msg.Headers, 1)
+				assert.Equal(t, event.ID.String(), string(msg.Headers[0].Value))
+				return nil
+			})
+
+			producer, err := newOrderProducer(syncProducer, testTopic)
+			require.NoError(t, err)
+
+			err = producer.Publish(context.Background(), event)
+			require.NoError(t, err)
+			require.NoError(t, producer.Close())
+		})
+	}
+}
+
+func TestOrderProducer_SkipsNotOrderStatusEvents(t *testing.T) {
+	syncProducer := mocks.NewSyncProducer(t, nil)
+
+	producer, err := newOrderProducer(syncProducer, testTopic)
+	require.NoError(t, err)
+
+	err = producer.Publish(context.Background(), testDomainEvent{ID: uuid.New()})
+	require.NoError(t, err)
+	require.NoError(t, producer.Close())
+}
+
+func TestOrderProducer_SendError(t *testing.T) {
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	syncProducer.ExpectSendMessageAndFail(errors.New("broker is not available"))
+
+	producer, err := newOrderProducer(syncProducer, testTopic)
+	require.NoError(t, err)
+
+	event := testOrderStatusChangedEvent{ID: uuid.New(), OrderID: uuid.New(), Status: order.StatusAssigned}
+	err = producer.Publish(context.Background(), event)
+	require.Error(t, err)
+	require.NoError(t, producer.Close())
+}
+
+type testDomainEvent struct {
+	ID uuid.UUID
+}
+
+func (e testDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e testDomainEvent) GetName() string {
+	return "testDomainEvent"
+}
+
+type testOrderStatusChangedEvent struct {
+	ID      uuid.UUID
+	OrderID uuid.UUID
+	Status  order.Status
+}
+
+func (e testOrderStatusChangedEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e testOrderStatusChangedEvent) GetName() string {
+	return "testOrderStatusChangedEvent"
+}
+
+func (e testOrderStatusChangedEvent) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+func (e testOrderStatusChangedEvent) GetOrderStatus() order.Status {
+	return e.Status
+}
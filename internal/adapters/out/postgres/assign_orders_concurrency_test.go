@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/ddd"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitOfWork_LockedRowsAreSkippedByConcurrentTransactions(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	seed, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.NoError(t, err)
+
+	for range 2 {
+		o, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 5)
+		require.NoError(t, err)
+		require.NoError(t, seed.OrderRepository().Add(ctx, o))
+	}
+
+	c, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
+	require.NoError(t, err)
+	require.NoError(t, c.StartShift())
+	require.NoError(t, seed.CourierRepository().Add(ctx, c))
+
+	first, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.NoError(t, err)
+	second, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.NoError(t, err)
+
+	first.Begin(ctx)
+	defer first.RollbackUnlessCommitted(ctx)
+	second.Begin(ctx)
+	defer second.RollbackUnlessCommitted(ctx)
+
+	firstOrder, err := first.OrderRepository().GetFirstInCreatedStatus(ctx)
+	require.NoError(t, err)
+	secondOrder, err := second.OrderRepository().GetFirstInCreatedStatus(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, firstOrder.ID(), secondOrder.ID())
+
+	firstCouriers, err := first.CourierRepository().GetAllAvailable(ctx)
+	require.NoError(t, err)
+	assert.Len(t, firstCouriers, 1)
+
+	secondCouriers, err := second.CourierRepository().GetAllAvailable(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, secondCouriers)
+}
+
+func TestAssignOrdersCommandHandler_ConcurrentRunsDoNotAssignCourierTwice(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	const (
+		ordersCount   = 12
+		couriersCount = 3
+		workersCount  = 4
+	)
+
+	seed, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.NoError(t, err)
+
+	for range ordersCount {
+		o, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 5)
+		require.NoError(t, err)
+		require.NoError(t, seed.OrderRepository().Add(ctx, o))
+	}
+
+	// У каждого курьера одно место хранения, поэтому назначить можно только couriersCount заказов
+	for range couriersCount {
+		c, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
+		require.NoError(t, err)
+		require.NoError(t, c.StartShift())
+		require.NoError(t, seed.CourierRepository().Add(ctx, c))
+	}
+
+	uowFactory, err := NewUnitOfWorkFactory(db, ddd.NewMediatr())
+	require.NoError(t, err)
+
+	handler, err := commands.NewAssignOrdersCommandHandler(uowFactory, services.NewOrderDispatcher())
+	require.NoError(t, err)
+
+	command, err := commands.NewAssignOrderCommand()
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range workersCount {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range ordersCount {
+				assert.NoError(t, handler.Handle(ctx, command))
+			}
+		}()
+	}
+	wg.Wait()
+
+	var assigned []struct {
+		ID        uuid.UUID
+		CourierID uuid.UUID
+	}
+	err = db.Raw("SELECT id, courier_id FROM orders WHERE status = ?", order.StatusAssigned).Scan(&assigned).Error
+	require.NoError(t, err)
+	require.Len(t, assigned, couriersCount)
+
+	ordersByCourier := make(map[uuid.UUID]int)
+	for _, o := range assigned {
+		ordersByCourier[o.CourierID]++
+
+		var storedIn int64
+		err = db.Table("storage_places").Where("order_id = ?", o.ID).Count(&storedIn).Error
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), storedIn)
+	}
+
+	assert.Len(t, ordersByCourier, couriersCount)
+	for _, count := range ordersByCourier {
+		assert.Equal(t, 1, count)
+	}
+}
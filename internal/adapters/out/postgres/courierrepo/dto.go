@@ -1,15 +1,23 @@
 package courierrepo
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
 type CourierDTO struct {
-	ID            uuid.UUID `gorm:"type:uuid;primaryKey"`
-	Name          string
-	Speed         int
-	Location      LocationDTO        `gorm:"embedded;embeddedPrefix:location_"`
-	StoragePlaces []*StoragePlaceDTO `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE"`
+	ID                 uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name               string
+	VehicleType        string `gorm:"type:varchar(20);not null;default:'foot'"`
+	Speed              int
+	Location           LocationDTO        `gorm:"embedded;embeddedPrefix:location_"`
+	PendingDistance    float64            `gorm:"not null;default:0"`
+	StoragePlaces      []*StoragePlaceDTO `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE"`
+	Availability       string             `gorm:"type:varchar(20);not null;default:'off_shift'"`
+	Shifts             []*ShiftDTO        `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE"`
+	AllocationStrategy string             `gorm:"type:varchar(20);not null;default:'best-fit'"`
+	Version            int64              `gorm:"not null;default:0"`
 }
 
 type LocationDTO struct {
@@ -21,10 +29,19 @@ type StoragePlaceDTO struct {
 	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
 	Name        string
 	TotalVolume int
+	MaxWeight   int        `gorm:"not null;default:0"`
 	OrderID     *uuid.UUID `gorm:"type:uuid"`
 	CourierID   uuid.UUID  `gorm:"type:uuid;index"`
 }
 
+// ShiftDTO - смена курьера. Завершенные смены остаются в таблице для расчета оплаты
+type ShiftDTO struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CourierID uuid.UUID `gorm:"type:uuid;index"`
+	StartedAt time.Time `gorm:"not null"`
+	EndedAt   *time.Time
+}
+
 func (CourierDTO) TableName() string {
 	return "couriers"
 }
@@ -32,3 +49,7 @@ func (CourierDTO) TableName() string {
 func (StoragePlaceDTO) TableName() string {
 	return "storage_places"
 }
+
+func (ShiftDTO) TableName() string {
+	return "courier_shifts"
+}
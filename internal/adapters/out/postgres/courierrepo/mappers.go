@@ -7,9 +7,12 @@ import (
 
 func DomainToDTO(courier *courier.Courier) CourierDTO {
 	dto := CourierDTO{
-		ID:    courier.Id(),
-		Name:  courier.Name(),
-		Speed: courier.Speed(),
+		ID:              courier.Id(),
+		Name:            courier.Name(),
+		VehicleType:     courier.Vehicle().String(),
+		Speed:           courier.Speed(),
+		PendingDistance: courier.PendingDistance(),
+		Version:         courier.Version(),
 		Location: LocationDTO{
 			X: courier.Location().X(),
 			Y: courier.Location().Y(),
@@ -22,6 +25,7 @@ func DomainToDTO(courier *courier.Courier) CourierDTO {
 			ID:          storagePlace.Id(),
 			Name:        storagePlace.Name(),
 			TotalVolume: storagePlace.TotalVolume(),
+			MaxWeight:   storagePlace.MaxWeight(),
 			OrderID:     storagePlace.OrderID(),
 			CourierID:   courier.Id(),
 		}
@@ -29,16 +33,40 @@ func DomainToDTO(courier *courier.Courier) CourierDTO {
 
 	dto.StoragePlaces = sp
 
+	dto.Availability = courier.Availability().String()
+	dto.AllocationStrategy = string(courier.AllocationStrategy())
+
+	dto.Shifts = make([]*ShiftDTO, 0, 1)
+	if shift := courier.Shift(); shift != nil {
+		dto.Shifts = append(dto.Shifts, &ShiftDTO{
+			ID:        shift.Id(),
+			CourierID: courier.Id(),
+			StartedAt: shift.StartedAt(),
+			EndedAt:   shift.EndedAt(),
+		})
+	}
+
 	return dto
 }
 
 func DTOToDomain(dto CourierDTO) *courier.Courier {
 	sp := make([]*courier.StoragePlace, len(dto.StoragePlaces))
 	for i, storagePlaceDTO := range dto.StoragePlaces {
-		sp[i] = courier.RestoreStoragePlace(storagePlaceDTO.ID, storagePlaceDTO.Name, storagePlaceDTO.TotalVolume, storagePlaceDTO.OrderID)
+		sp[i] = courier.RestoreStoragePlace(
+			storagePlaceDTO.ID, storagePlaceDTO.Name, storagePlaceDTO.TotalVolume, storagePlaceDTO.MaxWeight, storagePlaceDTO.OrderID)
 	}
 
-	l, _ := kernel.NewLocation(dto.Location.X, dto.Location.Y)
+	l := kernel.RestoreLocation(dto.Location.X, dto.Location.Y)
+
+	// загружается только открытая смена, см. Repository.preload
+	var shift *courier.Shift
+	for _, shiftDTO := range dto.Shifts {
+		if shiftDTO.EndedAt == nil {
+			shift = courier.RestoreShift(shiftDTO.ID, shiftDTO.StartedAt, shiftDTO.EndedAt)
+		}
+	}
 
-	return courier.RestoreCourier(dto.ID, dto.Name, dto.Speed, l, sp)
+	return courier.RestoreCourier(
+		dto.ID, dto.Name, courier.VehicleType(dto.VehicleType), dto.Speed, l, sp, courier.Availability(dto.Availability), shift,
+		courier.AllocationStrategy(dto.AllocationStrategy), dto.PendingDistance, dto.Version)
 }
@@ -0,0 +1,57 @@
+package courierrepo
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StartShiftForAll открывает смену всем курьерам, у которых нет открытой смены.
+// Нужна при переходе на смены: до них любой курьер мог получить заказ
+func StartShiftForAll(db *gorm.DB, startedAt time.Time) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var ids []uuid.UUID
+		err := tx.Model(&CourierDTO{}).
+			Where(`NOT EXISTS (
+                SELECT 1 FROM courier_shifts cs
+                WHERE cs.courier_id = couriers.id AND cs.ended_at IS NULL
+            )`).
+			Pluck("id", &ids).Error
+		if err != nil {
+			return err
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		shifts := make([]*ShiftDTO, 0, len(ids))
+		for _, id := range ids {
+			shifts = append(shifts, &ShiftDTO{ID: uuid.New(), CourierID: id, StartedAt: startedAt})
+		}
+
+		err = tx.Create(&shifts).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&CourierDTO{}).
+			Where("id IN ?", ids).
+			Update("availability", courier.AvailabilityOnShift.String()).Error
+	})
+}
+
+// CountOutsideGrid возвращает число курьеров, стоящих за границами карты grid.
+// Карту могли уменьшить после того, как курьеры были сохранены
+func CountOutsideGrid(db *gorm.DB, grid kernel.Grid) (int64, error) {
+	var count int64
+	err := db.Model(&CourierDTO{}).
+		Where("location_x NOT BETWEEN ? AND ? OR location_y NOT BETWEEN ? AND ?",
+			grid.MinX(), grid.MaxX(), grid.MinY(), grid.MaxY()).
+		Count(&count).Error
+
+	return count, err
+}
@@ -2,6 +2,7 @@ package courierrepo
 
 import (
 	"context"
+	"delivery/internal/adapters/out/postgres/locking"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
@@ -36,19 +37,48 @@ func (r *Repository) Add(ctx context.Context, aggregate *courier.Courier) error
 			return err
 		}
 
+		r.tracker.Track(aggregate)
 		return nil
 	})
 }
 
+// Update сохраняет курьера, только если его версия в базе не изменилась с момента чтения.
+// Иначе курьера уже изменили параллельно и возвращается ошибка версии.
 func (r *Repository) Update(ctx context.Context, aggregate *courier.Courier) error {
 	return r.withTx(ctx, func(tx *gorm.DB) error {
 		dto := DomainToDTO(aggregate)
+		version := r.tracker.StoredVersion(aggregate)
+		dto.Version = version + 1
+
+		result := tx.WithContext(ctx).
+			Model(&dto).
+			Where("version = ?", version).
+			Select("*").
+			Omit(clause.Associations).
+			Updates(&dto)
+		if result.Error != nil {
+			return result.Error
+		}
 
-		err := tx.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Save(&dto).Error
-		if err != nil {
-			return err
+		if result.RowsAffected == 0 {
+			return errs.NewVersionIsInvalidError("courier", nil)
+		}
+
+		if len(dto.StoragePlaces) > 0 {
+			err := tx.WithContext(ctx).Save(dto.StoragePlaces).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(dto.Shifts) > 0 {
+			err := tx.WithContext(ctx).Save(dto.Shifts).Error
+			if err != nil {
+				return err
+			}
 		}
 
+		r.tracker.TrackUpdate(aggregate)
 		return nil
 	})
 }
@@ -57,27 +87,56 @@ func (r *Repository) Get(ctx context.Context, ID uuid.UUID) (*courier.Courier, e
 	dto := CourierDTO{}
 
 	tx := r.getTxOrDb()
-	result := tx.WithContext(ctx).
-		Preload(clause.Associations).
+	result := r.preload(tx.WithContext(ctx)).
 		Find(&dto, ID)
 	if result.RowsAffected == 0 {
 		return nil, errs.NewObjectNotFoundError("Courier", ID)
 	}
 
-	aggregate := DTOToDomain(dto)
-	return aggregate, nil
+	return DTOToDomain(dto), nil
 }
 
+// GetAllFree возвращает курьеров на смене, которые не везут ни одного заказа
 func (r *Repository) GetAllFree(ctx context.Context) ([]*courier.Courier, error) {
 	var dtos []CourierDTO
 
 	tx := r.getTxOrDb()
-	result := tx.WithContext(ctx).
-		Preload(clause.Associations).
+	result := locking.SkipLockedInTx(r.preload(tx.WithContext(ctx)).
+		Where("availability = ?", courier.AvailabilityOnShift).
 		Where(`NOT EXISTS (
             SELECT 1 FROM storage_places sp
             WHERE sp.courier_id = couriers.id AND sp.order_id IS NOT NULL
-        )`).
+        )`), r.tracker.InTx()).
+		Find(&dtos)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return []*courier.Courier{}, nil
+	}
+
+	aggregates := make([]*courier.Courier, len(dtos))
+	for i, dto := range dtos {
+		aggregates[i] = DTOToDomain(dto)
+	}
+
+	return aggregates, nil
+}
+
+// GetAllAvailable возвращает курьеров на смене, у которых есть хотя бы одно свободное место хранения,
+// в том числе уже везущих другие заказы. Внутри транзакции строки блокируются
+// (FOR UPDATE SKIP LOCKED), поэтому одного курьера не назначат параллельно дважды.
+func (r *Repository) GetAllAvailable(ctx context.Context) ([]*courier.Courier, error) {
+	var dtos []CourierDTO
+
+	tx := r.getTxOrDb()
+	result := locking.SkipLockedInTx(r.preload(tx.WithContext(ctx)).
+		Where("availability = ?", courier.AvailabilityOnShift).
+		Where(`EXISTS (
+            SELECT 1 FROM storage_places sp
+            WHERE sp.courier_id = couriers.id AND sp.order_id IS NULL
+        )`), r.tracker.InTx()).
 		Find(&dtos)
 	if result.Error != nil {
 		return nil, result.Error
@@ -95,6 +154,14 @@ func (r *Repository) GetAllFree(ctx context.Context) ([]*courier.Courier, error)
 	return aggregates, nil
 }
 
+// preload загружает места хранения и только открытую смену: завершенные смены
+// нужны лишь для отчетов и в агрегат не попадают
+func (r *Repository) preload(query *gorm.DB) *gorm.DB {
+	return query.
+		Preload("StoragePlaces").
+		Preload("Shifts", "ended_at IS NULL")
+}
+
 func (r *Repository) getTxOrDb() *gorm.DB {
 	if tx := r.tracker.Tx(); tx != nil {
 		return tx
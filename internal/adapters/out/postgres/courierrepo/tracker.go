@@ -12,6 +12,10 @@ type Tracker interface {
 	Db() *gorm.DB
 	InTx() bool
 	Track(agg ddd.AggregateRoot)
+	// TrackUpdate отмечает сохранение агрегата, версия агрегата увеличивается после фиксации транзакции
+	TrackUpdate(agg ddd.VersionedAggregate)
+	// StoredVersion возвращает версию агрегата в базе с учетом еще не зафиксированных сохранений
+	StoredVersion(agg ddd.VersionedAggregate) int64
 	Begin(ctx context.Context)
 	Commit(ctx context.Context) error
 }
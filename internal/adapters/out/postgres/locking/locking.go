@@ -0,0 +1,17 @@
+package locking
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SkipLockedInTx блокирует выбранные строки до конца транзакции, пропуская уже заблокированные
+// другими транзакциями. Поэтому обработчики, распределяющие заказы, начинают транзакцию до чтения:
+// параллельные запуски не получат те же заказы и курьеров.
+// Вне транзакции блокировка не имеет смысла и не добавляется.
+func SkipLockedInTx(query *gorm.DB, inTx bool) *gorm.DB {
+	if !inTx {
+		return query
+	}
+	return query.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked})
+}
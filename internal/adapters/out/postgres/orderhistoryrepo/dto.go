@@ -0,0 +1,20 @@
+package orderhistoryrepo
+
+import (
+	"delivery/internal/core/domain/model/order"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusChangeDTO - смена статуса заказа. ID совпадает с идентификатором доменного события
+type StatusChangeDTO struct {
+	ID        uuid.UUID    `gorm:"type:uuid;primaryKey"`
+	OrderID   uuid.UUID    `gorm:"type:uuid;index"`
+	Status    order.Status `gorm:"type:varchar(20)"`
+	ChangedAt time.Time    `gorm:"not null"`
+}
+
+func (StatusChangeDTO) TableName() string {
+	return "order_status_history"
+}
@@ -0,0 +1,18 @@
+package orderhistoryrepo
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// BackfillCurrentStatus добавляет текущий статус в историю заказов, сохраненных до ее появления.
+// Когда заказ получил этот статус, неизвестно, поэтому временем смены считается changedAt
+func BackfillCurrentStatus(db *gorm.DB, changedAt time.Time) error {
+	return db.Exec(`
+		INSERT INTO order_status_history (id, order_id, status, changed_at)
+		SELECT gen_random_uuid(), o.id, o.status, ?
+		FROM orders o
+		WHERE NOT EXISTS (SELECT 1 FROM order_status_history h WHERE h.order_id = o.id)`,
+		changedAt.UTC()).Error
+}
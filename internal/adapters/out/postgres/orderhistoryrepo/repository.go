@@ -0,0 +1,71 @@
+package orderhistoryrepo
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ ports.OrderHistoryRepository = &Repository{}
+
+type Repository struct {
+	tracker Tracker
+}
+
+func NewRepository(tracker Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+
+	return &Repository{
+		tracker: tracker,
+	}, nil
+}
+
+func (r *Repository) Add(ctx context.Context, changeID uuid.UUID, orderID uuid.UUID, status order.Status, changedAt time.Time) error {
+	if changeID == uuid.Nil {
+		return errs.NewValueIsRequiredError("changeID")
+	}
+
+	if orderID == uuid.Nil {
+		return errs.NewValueIsRequiredError("orderID")
+	}
+
+	if status.IsEmpty() {
+		return errs.NewValueIsRequiredError("status")
+	}
+
+	return r.withTx(ctx, func(tx *gorm.DB) error {
+		dto := StatusChangeDTO{
+			ID:        changeID,
+			OrderID:   orderID,
+			Status:    status,
+			ChangedAt: changedAt.UTC(),
+		}
+
+		return tx.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&dto).Error
+	})
+}
+
+func (r *Repository) withTx(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	isInTx := r.tracker.InTx()
+	if !isInTx {
+		r.tracker.Begin(ctx)
+	}
+	tx := r.tracker.Tx()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if !isInTx {
+		return r.tracker.Commit(ctx)
+	}
+	return nil
+}
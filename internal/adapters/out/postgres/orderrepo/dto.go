@@ -2,16 +2,25 @@ package orderrepo
 
 import (
 	"delivery/internal/core/domain/model/order"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type OrderDTO struct {
-	ID        uuid.UUID   `gorm:"type:uuid;primaryKey"`
-	CourierID *uuid.UUID  `gorm:"type:uuid;index"`
-	Location  LocationDTO `gorm:"embedded;embeddedPrefix:location_"`
-	Volume    int
-	Status    order.Status `gorm:"type:varchar(20)"`
+	ID             uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	CourierID      *uuid.UUID  `gorm:"type:uuid;index"`
+	PickupLocation LocationDTO `gorm:"embedded;embeddedPrefix:pickup_location_"`
+	Location       LocationDTO `gorm:"embedded;embeddedPrefix:location_"`
+	Volume         int
+	Weight         int               `gorm:"not null;default:0"`
+	Dimensions     DimensionsDTO     `gorm:"embedded;embeddedPrefix:dimensions_"`
+	Items          []*ItemDTO        `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+	Priority       int               `gorm:"not null;default:2"`
+	DeliveryWindow DeliveryWindowDTO `gorm:"embedded;embeddedPrefix:delivery_window_"`
+	AtRisk         bool              `gorm:"not null;default:false"`
+	Status         order.Status      `gorm:"type:varchar(20)"`
+	Version        int64             `gorm:"not null;default:0"`
 }
 
 type LocationDTO struct {
@@ -19,6 +28,32 @@ type LocationDTO struct {
 	Y int
 }
 
+// DimensionsDTO - габариты заказа, нули означают, что габариты неизвестны
+type DimensionsDTO struct {
+	Length int `gorm:"not null;default:0"`
+	Width  int `gorm:"not null;default:0"`
+	Height int `gorm:"not null;default:0"`
+}
+
+// ItemDTO - позиция заказа. Position сохраняет порядок позиций из корзины
+type ItemDTO struct {
+	OrderID  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Position int       `gorm:"primaryKey;autoIncrement:false"`
+	GoodID   uuid.UUID `gorm:"type:uuid"`
+	Title    string
+	Quantity int
+}
+
+// DeliveryWindowDTO - обещанный интервал доставки, NULL - интервал не обещан
+type DeliveryWindowDTO struct {
+	From *time.Time
+	To   *time.Time
+}
+
 func (OrderDTO) TableName() string {
 	return "orders"
 }
+
+func (ItemDTO) TableName() string {
+	return "order_items"
+}
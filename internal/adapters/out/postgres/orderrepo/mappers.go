@@ -3,24 +3,86 @@ package orderrepo
 import (
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"slices"
 )
 
 func DomainToDTO(aggregate *order.Order) OrderDTO {
 	var orderDTO OrderDTO
 	orderDTO.ID = aggregate.ID()
 	orderDTO.CourierID = aggregate.CourierID()
+	orderDTO.PickupLocation = LocationDTO{
+		X: aggregate.PickupLocation().X(),
+		Y: aggregate.PickupLocation().Y(),
+	}
 	orderDTO.Location = LocationDTO{
 		X: aggregate.Location().X(),
 		Y: aggregate.Location().Y(),
 	}
 	orderDTO.Volume = aggregate.Volume()
+	orderDTO.Weight = aggregate.Weight()
+	orderDTO.Dimensions = DimensionsDTO{
+		Length: aggregate.Details().Dimensions().Length(),
+		Width:  aggregate.Details().Dimensions().Width(),
+		Height: aggregate.Details().Dimensions().Height(),
+	}
+	orderDTO.Items = make([]*ItemDTO, 0, len(aggregate.Details().Items()))
+	for i, item := range aggregate.Details().Items() {
+		orderDTO.Items = append(orderDTO.Items, &ItemDTO{
+			OrderID:  aggregate.ID(),
+			Position: i,
+			GoodID:   item.GoodID(),
+			Title:    item.Title(),
+			Quantity: item.Quantity(),
+		})
+	}
+	orderDTO.Priority = int(aggregate.Priority())
+	if window := aggregate.DeliveryWindow(); window.IsValid() {
+		from, to := window.From(), window.To()
+		orderDTO.DeliveryWindow = DeliveryWindowDTO{From: &from, To: &to}
+	}
+	orderDTO.AtRisk = aggregate.IsAtRisk()
 	orderDTO.Status = aggregate.Status()
+	orderDTO.Version = aggregate.Version()
 	return orderDTO
 }
 
 func DtoToDomain(dto OrderDTO) *order.Order {
 	var aggregate *order.Order
-	location, _ := kernel.NewLocation(dto.Location.X, dto.Location.Y)
-	aggregate = order.RestoreOrder(dto.ID, dto.CourierID, location, dto.Volume, dto.Status)
+	pickupLocation := kernel.RestoreLocation(dto.PickupLocation.X, dto.PickupLocation.Y)
+	location := kernel.RestoreLocation(dto.Location.X, dto.Location.Y)
+
+	// у заказов, созданных до появления габаритов, они остаются неизвестными
+	dimensions, _ := order.NewDimensions(dto.Dimensions.Length, dto.Dimensions.Width, dto.Dimensions.Height)
+
+	itemDTOs := slices.Clone(dto.Items)
+	slices.SortFunc(itemDTOs, func(a, b *ItemDTO) int {
+		return a.Position - b.Position
+	})
+
+	items := make([]order.Item, 0, len(itemDTOs))
+	for _, itemDTO := range itemDTOs {
+		item, _ := order.NewItem(itemDTO.GoodID, itemDTO.Title, itemDTO.Quantity)
+		items = append(items, item)
+	}
+
+	details, _ := order.NewDetails(dto.Weight, dimensions, items)
+
+	var window order.DeliveryWindow
+	if dto.DeliveryWindow.From != nil && dto.DeliveryWindow.To != nil {
+		window, _ = order.NewDeliveryWindow(*dto.DeliveryWindow.From, *dto.DeliveryWindow.To)
+	}
+
+	aggregate = order.RestoreOrder(
+		dto.ID,
+		dto.CourierID,
+		pickupLocation,
+		location,
+		dto.Volume,
+		details,
+		order.Priority(dto.Priority),
+		window,
+		dto.AtRisk,
+		dto.Status,
+		dto.Version)
 	return aggregate
 }
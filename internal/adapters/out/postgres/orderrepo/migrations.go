@@ -0,0 +1,35 @@
+package orderrepo
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"gorm.io/gorm"
+)
+
+// BackfillPickupLocation заполняет место забора у заказов, созданных до его появления.
+// Нераспределенные заказы забираются со склада, а уже назначенные курьер везет с собой,
+// поэтому для них место забора совпадает с местом доставки
+func BackfillPickupLocation(db *gorm.DB, warehouse kernel.Location) error {
+	return db.Model(&OrderDTO{}).
+		Where("pickup_location_x IS NULL OR pickup_location_y IS NULL").
+		Updates(map[string]any{
+			"pickup_location_x": gorm.Expr("CASE WHEN status = ? THEN ? ELSE location_x END", order.StatusCreated, warehouse.X()),
+			"pickup_location_y": gorm.Expr("CASE WHEN status = ? THEN ? ELSE location_y END", order.StatusCreated, warehouse.Y()),
+		}).Error
+}
+
+// CountOutsideGrid возвращает число незавершенных заказов, место забора или доставки которых
+// лежит за границами карты grid. Завершенные и отмененные заказы курьерам больше не достаются
+func CountOutsideGrid(db *gorm.DB, grid kernel.Grid) (int64, error) {
+	var count int64
+	err := db.Model(&OrderDTO{}).
+		Where("status IN ?", []order.Status{order.StatusCreated, order.StatusAssigned, order.StatusPickedUp}).
+		Where(`location_x NOT BETWEEN ? AND ? OR location_y NOT BETWEEN ? AND ?
+            OR pickup_location_x NOT BETWEEN ? AND ? OR pickup_location_y NOT BETWEEN ? AND ?`,
+			grid.MinX(), grid.MaxX(), grid.MinY(), grid.MaxY(),
+			grid.MinX(), grid.MaxX(), grid.MinY(), grid.MaxY()).
+		Count(&count).Error
+
+	return count, err
+}
@@ -2,18 +2,27 @@ package orderrepo
 
 import (
 	"context"
+	"delivery/internal/adapters/out/postgres/locking"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
 	"errors"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 var _ ports.OrderRepository = &Repository{}
 
+const (
+	// uniqueViolationCode - код ошибки Postgres при нарушении уникальности
+	uniqueViolationCode = "23505"
+	// noLimit отменяет ограничение числа строк в запросе
+	noLimit = -1
+)
+
 type Repository struct {
 	tracker Tracker
 }
@@ -33,23 +42,41 @@ func (r *Repository) Add(ctx context.Context, aggregate *order.Order) error {
 		dto := DomainToDTO(aggregate)
 
 		err := tx.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Create(&dto).Error
+		if isUniqueViolation(err) {
+			return errs.NewObjectAlreadyExistsError("order", aggregate.ID())
+		}
 		if err != nil {
 			return err
 		}
 
+		r.tracker.Track(aggregate)
 		return nil
 	})
 }
 
+// Update сохраняет заказ, только если его версия в базе не изменилась с момента чтения.
+// Иначе заказ уже изменили параллельно и возвращается ошибка версии.
 func (r *Repository) Update(ctx context.Context, aggregate *order.Order) error {
 	return r.withTx(ctx, func(tx *gorm.DB) error {
 		dto := DomainToDTO(aggregate)
+		version := r.tracker.StoredVersion(aggregate)
+		dto.Version = version + 1
+
+		result := tx.WithContext(ctx).
+			Model(&dto).
+			Where("version = ?", version).
+			Select("*").
+			Omit(clause.Associations).
+			Updates(&dto)
+		if result.Error != nil {
+			return result.Error
+		}
 
-		err := tx.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Save(&dto).Error
-		if err != nil {
-			return err
+		if result.RowsAffected == 0 {
+			return errs.NewVersionIsInvalidError("order", nil)
 		}
 
+		r.tracker.TrackUpdate(aggregate)
 		return nil
 	})
 }
@@ -65,17 +92,19 @@ func (r *Repository) Get(ctx context.Context, id uuid.UUID) (*order.Order, error
 		return nil, errs.NewObjectNotFoundError("Order", id)
 	}
 
-	aggregate := DtoToDomain(dto)
-	return aggregate, nil
+	return DtoToDomain(dto), nil
 }
 
+// GetFirstInCreatedStatus возвращает самый срочный созданный заказ, см. byUrgency.
+// Внутри транзакции строка блокируется (FOR UPDATE SKIP LOCKED), поэтому параллельные
+// обработчики получат разные заказы.
 func (r *Repository) GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error) {
 	dto := OrderDTO{}
 
 	tx := r.getTxOrDb()
-	result := tx.WithContext(ctx).
+	result := locking.SkipLockedInTx(byUrgency(tx.WithContext(ctx).
 		Preload(clause.Associations).
-		Where("status = ?", order.StatusCreated).
+		Where("status = ?", order.StatusCreated)), r.tracker.InTx()).
 		First(&dto)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -84,17 +113,42 @@ func (r *Repository) GetFirstInCreatedStatus(ctx context.Context) (*order.Order,
 		return nil, result.Error
 	}
 
-	aggregate := DtoToDomain(dto)
-	return aggregate, nil
+	return DtoToDomain(dto), nil
+}
+
+// GetMostUrgentInCreatedStatus возвращает не больше limit самых срочных созданных заказов,
+// внутри транзакции блокируя их так же, как GetFirstInCreatedStatus
+func (r *Repository) GetMostUrgentInCreatedStatus(ctx context.Context, limit int) ([]*order.Order, error) {
+	if limit <= 0 {
+		return nil, errs.NewValueIsInvalidError("limit")
+	}
+
+	return r.getInStatus(ctx, limit, order.StatusCreated)
+}
+
+// GetAllInCreatedStatus возвращает созданные заказы от самых срочных, внутри транзакции блокируя их
+// так же, как GetFirstInCreatedStatus
+func (r *Repository) GetAllInCreatedStatus(ctx context.Context) ([]*order.Order, error) {
+	return r.getInStatus(ctx, noLimit, order.StatusCreated)
 }
 
 func (r *Repository) GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error) {
+	return r.getInStatus(ctx, noLimit, order.StatusAssigned)
+}
+
+// GetAllInDelivery возвращает заказы, которые курьеры везут к месту забора или получателю
+func (r *Repository) GetAllInDelivery(ctx context.Context) ([]*order.Order, error) {
+	return r.getInStatus(ctx, noLimit, order.StatusAssigned, order.StatusPickedUp)
+}
+
+func (r *Repository) getInStatus(ctx context.Context, limit int, statuses ...order.Status) ([]*order.Order, error) {
 	var dtos []OrderDTO
 
 	tx := r.getTxOrDb()
-	result := tx.WithContext(ctx).
+	result := locking.SkipLockedInTx(byUrgency(tx.WithContext(ctx).
 		Preload(clause.Associations).
-		Where("status = ?", order.StatusAssigned).
+		Where("status IN ?", statuses)), r.tracker.InTx()).
+		Limit(limit).
 		Find(&dtos)
 	if result.Error != nil {
 		return nil, result.Error
@@ -111,6 +165,12 @@ func (r *Repository) GetAllInAssignedStatus(ctx context.Context) ([]*order.Order
 	return aggregates, nil
 }
 
+// byUrgency упорядочивает заказы по убыванию приоритета, затем по концу обещанного интервала доставки.
+// Заказы без интервала идут после заказов с интервалом того же приоритета
+func byUrgency(query *gorm.DB) *gorm.DB {
+	return query.Order("priority DESC, delivery_window_to ASC NULLS LAST, id")
+}
+
 func (r *Repository) getTxOrDb() *gorm.DB {
 	if tx := r.tracker.Tx(); tx != nil {
 		return tx
@@ -134,3 +194,8 @@ func (r *Repository) withTx(ctx context.Context, fn func(tx *gorm.DB) error) err
 	}
 	return nil
 }
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
@@ -0,0 +1,92 @@
+package outboxrepo
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/outbox"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ ports.OutboxRepository = &Repository{}
+
+type Repository struct {
+	tracker Tracker
+}
+
+func NewRepository(tracker Tracker) (*Repository, error) {
+	if tracker == nil {
+		return nil, errs.NewValueIsRequiredError("tracker")
+	}
+
+	return &Repository{
+		tracker: tracker,
+	}, nil
+}
+
+func (r *Repository) Add(ctx context.Context, message *outbox.Message) error {
+	return r.withTx(ctx, func(tx *gorm.DB) error {
+		return tx.WithContext(ctx).Create(message).Error
+	})
+}
+
+func (r *Repository) Update(ctx context.Context, message *outbox.Message) error {
+	return r.withTx(ctx, func(tx *gorm.DB) error {
+		err := tx.WithContext(ctx).Save(message).Error
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// GetNotPublishedMessages возвращает неопубликованные сообщения в порядке их возникновения,
+// включая отложенные после неудачной попытки: до их публикации следующие ждут.
+// Внутри транзакции строки блокируются (FOR UPDATE SKIP LOCKED), поэтому несколько
+// экземпляров сервиса не опубликуют одно и то же сообщение повторно.
+func (r *Repository) GetNotPublishedMessages(ctx context.Context, limit int) ([]*outbox.Message, error) {
+	var messages []*outbox.Message
+
+	tx := r.getTxOrDb()
+	query := tx.WithContext(ctx).
+		Where("processed_at_utc IS NULL").
+		Order("occurred_at_utc").
+		Limit(limit)
+	if r.tracker.InTx() {
+		query = query.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked})
+	}
+
+	result := query.Find(&messages)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return messages, nil
+}
+
+func (r *Repository) getTxOrDb() *gorm.DB {
+	if tx := r.tracker.Tx(); tx != nil {
+		return tx
+	}
+	return r.tracker.Db()
+}
+
+func (r *Repository) withTx(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	isInTx := r.tracker.InTx()
+	if !isInTx {
+		r.tracker.Begin(ctx)
+	}
+	tx := r.tracker.Tx()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if !isInTx {
+		return r.tracker.Commit(ctx)
+	}
+	return nil
+}
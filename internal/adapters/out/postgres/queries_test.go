@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tests"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAllCouriersQueryHandler_PaginatesAndFilters(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.NoError(t, err)
+
+	busy := tests.CreateCourier("Alice", 1, tests.CreateLocation(2, 2))
+	require.NoError(t, busy.TakeOrder(tests.CreateOrder(uuid.New(), tests.CreateLocation(3, 3), 5)))
+	onBreak := tests.CreateCourier("Carol", 1, tests.CreateLocation(4, 4))
+	require.NoError(t, onBreak.StartBreak())
+	for _, c := range []*courier.Courier{
+		busy,
+		tests.CreateCourier("Bob", 1, tests.CreateLocation(3, 3)),
+		onBreak,
+		tests.CreateCourier("Dave", 1, tests.CreateLocation(9, 9)),
+	} {
+		require.NoError(t, uow.CourierRepository().Add(ctx, c))
+	}
+
+	handler, err := queries.NewGetAllCouriersQueryHandler(db)
+	require.NoError(t, err)
+
+	var names []string
+	request := queries.PageRequest{Limit: 3, Sort: "-name"}
+	for {
+		query, err := queries.NewGetAllCouriersQuery(queries.CouriersFilter{}, request)
+		require.NoError(t, err)
+
+		response, err := handler.Handle(query)
+		require.NoError(t, err)
+		for _, c := range response.Couriers {
+			names = append(names, c.Name)
+		}
+
+		if response.NextCursor == "" {
+			break
+		}
+		request.Cursor = response.NextCursor
+	}
+	assert.Equal(t, []string{"Dave", "Carol", "Bob", "Alice"}, names)
+
+	free, maxX := false, 5
+	query, err := queries.NewGetAllCouriersQuery(
+		queries.CouriersFilter{Busy: &free, Area: queries.Area{MaxX: &maxX}}, queries.PageRequest{Sort: "name"})
+	require.NoError(t, err)
+
+	response, err := handler.Handle(query)
+	require.NoError(t, err)
+	require.Len(t, response.Couriers, 2)
+	assert.Equal(t, "Bob", response.Couriers[0].Name)
+	assert.Equal(t, "Carol", response.Couriers[1].Name)
+	assert.Empty(t, response.NextCursor)
+
+	query, err = queries.NewGetAllCouriersQuery(
+		queries.CouriersFilter{Availabilities: []courier.Availability{courier.AvailabilityOnBreak}}, queries.PageRequest{})
+	require.NoError(t, err)
+
+	response, err = handler.Handle(query)
+	require.NoError(t, err)
+	require.Len(t, response.Couriers, 1)
+	assert.Equal(t, onBreak.Id(), response.Couriers[0].ID)
+
+	_, err = queries.NewGetAllCouriersQuery(
+		queries.CouriersFilter{Availabilities: []courier.Availability{"asleep"}}, queries.PageRequest{})
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestGetOrdersQueryHandler_PaginatesAndFilters(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.NoError(t, err)
+
+	courierID := uuid.New()
+	assigned := tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 3)
+	require.NoError(t, assigned.Assign(courierID))
+	canceled := tests.CreateOrder(uuid.New(), tests.CreateLocation(2, 2), 1)
+	require.NoError(t, canceled.Cancel())
+	created := []*order.Order{
+		tests.CreateOrder(uuid.New(), tests.CreateLocation(5, 5), 2),
+		tests.CreateOrder(uuid.New(), tests.CreateLocation(8, 8), 4),
+	}
+
+	for _, o := range append([]*order.Order{assigned, canceled}, created...) {
+		require.NoError(t, uow.OrderRepository().Add(ctx, o))
+	}
+
+	handler, err := queries.NewGetOrdersQueryHandler(db)
+	require.NoError(t, err)
+
+	var volumes []int
+	request := queries.PageRequest{Limit: 1, Sort: "volume"}
+	for {
+		query, err := queries.NewGetOrdersQuery(queries.OrdersFilter{NotCompleted: true}, request)
+		require.NoError(t, err)
+
+		response, err := handler.Handle(query)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(response.Orders), 1)
+		for _, o := range response.Orders {
+			volumes = append(volumes, o.Volume)
+		}
+
+		if response.NextCursor == "" {
+			break
+		}
+		request.Cursor = response.NextCursor
+	}
+	assert.Equal(t, []int{2, 3, 4}, volumes)
+
+	testCases := []struct {
+		name   string
+		filter queries.OrdersFilter
+		want   []uuid.UUID
+	}{
+		{
+			name:   "By status",
+			filter: queries.OrdersFilter{Statuses: []order.Status{order.StatusCanceled}},
+			want:   []uuid.UUID{canceled.ID()},
+		},
+		{
+			name:   "By courier",
+			filter: queries.OrdersFilter{CourierID: &courierID},
+			want:   []uuid.UUID{assigned.ID()},
+		},
+		{
+			name:   "By area",
+			filter: queries.OrdersFilter{Area: queries.Area{MinX: intPtr(4), MinY: intPtr(4), MaxX: intPtr(6), MaxY: intPtr(6)}},
+			want:   []uuid.UUID{created[0].ID()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := queries.NewGetOrdersQuery(tc.filter, queries.PageRequest{})
+			require.NoError(t, err)
+
+			response, err := handler.Handle(query)
+			require.NoError(t, err)
+
+			got := make([]uuid.UUID, 0, len(response.Orders))
+			for _, o := range response.Orders {
+				got = append(got, o.ID)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGetCourierQueryHandler_ReturnsStoragePlacesAndTarget(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.NoError(t, err)
+
+	c := tests.CreateCourierWithVehicle("Alice", courier.VehicleBicycle, 2, tests.CreateLocation(1, 1))
+	o, err := order.NewOrder(uuid.New(), tests.CreateLocation(3, 1), tests.CreateLocation(9, 9), 4)
+	require.NoError(t, err)
+	require.NoError(t, c.TakeOrder(o))
+	require.NoError(t, o.Assign(c.Id()))
+	require.NoError(t, uow.OrderRepository().Add(ctx, o))
+	require.NoError(t, uow.CourierRepository().Add(ctx, c))
+
+	handler, err := queries.NewGetCourierQueryHandler(db)
+	require.NoError(t, err)
+
+	query, err := queries.NewGetCourierQuery(c.Id())
+	require.NoError(t, err)
+
+	before := time.Now().UTC()
+	got, err := handler.Handle(ctx, query)
+	require.NoError(t, err)
+
+	assert.Equal(t, c.Id(), got.ID)
+	assert.Equal(t, courier.VehicleBicycle.String(), got.VehicleType)
+	assert.Equal(t, 2, got.Speed)
+	require.Len(t, got.StoragePlaces, 2)
+
+	var used []queries.StoragePlaceResponse
+	for _, place := range got.StoragePlaces {
+		if place.OrderID != nil {
+			used = append(used, place)
+		}
+	}
+	require.Len(t, used, 1)
+	assert.Equal(t, o.ID(), *used[0].OrderID)
+	assert.Equal(t, 4, used[0].UsedVolume)
+
+	require.NotNil(t, got.Target)
+	assert.Equal(t, o.ID(), got.Target.OrderID)
+	assert.True(t, got.Target.IsPickup)
+	assert.Equal(t, queries.LocationResponse{X: 3, Y: 1}, got.Target.Location)
+	require.NotNil(t, got.Target.EstimatedArrivalAt)
+	assert.WithinDuration(t, before.Add(kernel.StepDuration), *got.Target.EstimatedArrivalAt, time.Second)
+
+	query, err = queries.NewGetCourierQuery(uuid.New())
+	require.NoError(t, err)
+	_, err = handler.Handle(ctx, query)
+	assert.ErrorIs(t, err, errs.ErrObjectNotFound)
+}
+
+func intPtr(v int) *int {
+	return &v
+}
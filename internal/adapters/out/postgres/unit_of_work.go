@@ -3,7 +3,9 @@ package postgres
 import (
 	"context"
 	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderhistoryrepo"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
@@ -20,17 +22,28 @@ type UnitOfWork struct {
 	db                *gorm.DB
 	committed         bool
 	trackedAggregates []ddd.AggregateRoot
+	// savedVersions - сколько раз агрегат сохранен в текущей транзакции
+	savedVersions     map[ddd.VersionedAggregate]int64
+	mediatr           ddd.Mediatr
 	courierRepository ports.CourierRepository
 	orderRepository   ports.OrderRepository
+	outboxRepository  ports.OutboxRepository
+
+	orderHistoryRepository ports.OrderHistoryRepository
 }
 
-func NewUnitOfWork(db *gorm.DB) (ports.UnitOfWork, error) {
+func NewUnitOfWork(db *gorm.DB, mediatr ddd.Mediatr) (ports.UnitOfWork, error) {
 	if db == nil {
 		return nil, errs.NewValueIsRequiredError("db")
 	}
 
+	if mediatr == nil {
+		return nil, errs.NewValueIsRequiredError("mediatr")
+	}
+
 	uow := &UnitOfWork{
-		db: db,
+		db:      db,
+		mediatr: mediatr,
 	}
 
 	courierRepo, err := courierrepo.NewRepository(uow)
@@ -45,6 +58,18 @@ func NewUnitOfWork(db *gorm.DB) (ports.UnitOfWork, error) {
 	}
 	uow.orderRepository = orderRepo
 
+	orderHistoryRepo, err := orderhistoryrepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.orderHistoryRepository = orderHistoryRepo
+
+	outboxRepo, err := outboxrepo.NewRepository(uow)
+	if err != nil {
+		return nil, err
+	}
+	uow.outboxRepository = outboxRepo
+
 	return uow, nil
 }
 
@@ -61,9 +86,27 @@ func (u *UnitOfWork) InTx() bool {
 }
 
 func (u *UnitOfWork) Track(agg ddd.AggregateRoot) {
+	for _, tracked := range u.trackedAggregates {
+		if tracked == agg {
+			return
+		}
+	}
 	u.trackedAggregates = append(u.trackedAggregates, agg)
 }
 
+func (u *UnitOfWork) TrackUpdate(agg ddd.VersionedAggregate) {
+	u.Track(agg)
+
+	if u.savedVersions == nil {
+		u.savedVersions = make(map[ddd.VersionedAggregate]int64)
+	}
+	u.savedVersions[agg]++
+}
+
+func (u *UnitOfWork) StoredVersion(agg ddd.VersionedAggregate) int64 {
+	return agg.Version() + u.savedVersions[agg]
+}
+
 func (u *UnitOfWork) CourierRepository() ports.CourierRepository {
 	return u.courierRepository
 }
@@ -72,6 +115,14 @@ func (u *UnitOfWork) OrderRepository() ports.OrderRepository {
 	return u.orderRepository
 }
 
+func (u *UnitOfWork) OrderHistoryRepository() ports.OrderHistoryRepository {
+	return u.orderHistoryRepository
+}
+
+func (u *UnitOfWork) OutboxRepository() ports.OutboxRepository {
+	return u.outboxRepository
+}
+
 func (u *UnitOfWork) Begin(ctx context.Context) {
 	u.tx = u.db.WithContext(ctx).Begin()
 	u.committed = false
@@ -82,12 +133,31 @@ func (u *UnitOfWork) Commit(ctx context.Context) error {
 		return errs.NewValueIsRequiredError("cannot commit without transaction")
 	}
 
+	if err := u.publishDomainEventsPreCommit(ctx); err != nil {
+		return err
+	}
+
+	domainEvents := u.collectDomainEvents()
+
 	if err := u.tx.WithContext(ctx).Commit().Error; err != nil {
 		return err
 	}
 
+	for _, agg := range u.trackedAggregates {
+		agg.ClearDomainEvents()
+	}
+
+	// до фиксации версия не меняется, чтобы после отката агрегат можно было сохранить повторно
+	for agg, saved := range u.savedVersions {
+		for range saved {
+			agg.IncrementVersion()
+		}
+	}
+
 	u.committed = true
 	u.clearTx()
+
+	u.publishDomainEvents(ctx, domainEvents)
 	return nil
 }
 
@@ -100,8 +170,39 @@ func (u *UnitOfWork) RollbackUnlessCommitted(ctx context.Context) {
 	}
 }
 
+func (u *UnitOfWork) collectDomainEvents() []ddd.DomainEvent {
+	var domainEvents []ddd.DomainEvent
+	for _, agg := range u.trackedAggregates {
+		domainEvents = append(domainEvents, agg.GetDomainEvents()...)
+	}
+	return domainEvents
+}
+
+// publishDomainEventsPreCommit вызывает обработчики внутри транзакции.
+// Ошибка обработчика отменяет фиксацию транзакции.
+func (u *UnitOfWork) publishDomainEventsPreCommit(ctx context.Context) error {
+	txCtx := ports.ContextWithUnitOfWork(ctx, u)
+	for _, event := range u.collectDomainEvents() {
+		if err := u.mediatr.PublishPreCommit(txCtx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishDomainEvents вызывает обработчики после фиксации транзакции.
+// Транзакцию уже не отменить, поэтому ошибки обработчиков только логируются.
+func (u *UnitOfWork) publishDomainEvents(ctx context.Context, domainEvents []ddd.DomainEvent) {
+	for _, event := range domainEvents {
+		if err := u.mediatr.Publish(ctx, event); err != nil {
+			log.Errorf("cannot handle domain event %s: %v", event.GetName(), err)
+		}
+	}
+}
+
 func (u *UnitOfWork) clearTx() {
 	u.tx = nil
 	u.trackedAggregates = nil
+	u.savedVersions = nil
 	u.committed = false
 }
@@ -3,21 +3,26 @@ package postgres
 import (
 	"context"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
 	"gorm.io/gorm"
 )
 
 type unitOfWorkFactory struct {
-	db *gorm.DB
+	db      *gorm.DB
+	mediatr ddd.Mediatr
 }
 
-func NewUnitOfWorkFactory(db *gorm.DB) (ports.UnitOfWorkFactory, error) {
+func NewUnitOfWorkFactory(db *gorm.DB, mediatr ddd.Mediatr) (ports.UnitOfWorkFactory, error) {
 	if db == nil {
 		return nil, errs.NewValueIsRequiredError("db")
 	}
-	return &unitOfWorkFactory{db: db}, nil
+	if mediatr == nil {
+		return nil, errs.NewValueIsRequiredError("mediatr")
+	}
+	return &unitOfWorkFactory{db: db, mediatr: mediatr}, nil
 }
 
 func (f *unitOfWorkFactory) New(ctx context.Context) (ports.UnitOfWork, error) {
-	return NewUnitOfWork(f.db.WithContext(ctx))
+	return NewUnitOfWork(f.db.WithContext(ctx), f.mediatr)
 }
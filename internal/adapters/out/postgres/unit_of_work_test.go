@@ -3,12 +3,20 @@ package postgres
 import (
 	"context"
 	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderhistoryrepo"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/eventhandlers"
+	"delivery/internal/core/application/usecases/queries"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/outbox"
 	"delivery/internal/pkg/testcnts"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -31,12 +39,21 @@ func setupTest(t *testing.T) (context.Context, *gorm.DB, error) {
 	assert.NoError(t, err)
 	err = db.AutoMigrate(&courierrepo.StoragePlaceDTO{})
 	assert.NoError(t, err)
+	err = db.AutoMigrate(&courierrepo.ShiftDTO{})
+	assert.NoError(t, err)
 	err = db.AutoMigrate(&orderrepo.OrderDTO{})
 	assert.NoError(t, err)
+	err = db.AutoMigrate(&orderrepo.ItemDTO{})
+	assert.NoError(t, err)
+	err = db.AutoMigrate(&orderhistoryrepo.StatusChangeDTO{})
+	assert.NoError(t, err)
 
 	err = db.AutoMigrate(&courierrepo.StoragePlaceDTO{})
 	assert.NoError(t, err)
 
+	err = db.AutoMigrate(&outbox.Message{})
+	assert.NoError(t, err)
+
 	t.Cleanup(func() {
 		err := postgresContainer.Terminate(ctx)
 		assert.NoError(t, err)
@@ -49,7 +66,7 @@ func TestUnitOfWork_CourierRepositoryShouldCanAddCourier(t *testing.T) {
 	ctx, db, err := setupTest(t)
 	require.Nil(t, err)
 
-	uow, err := NewUnitOfWork(db)
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
 	require.Nil(t, err)
 
 	location := kernel.MaxLocation()
@@ -69,14 +86,19 @@ func TestUnitOfWork_CourierRepositoryGetAllFree(t *testing.T) {
 	ctx, db, err := setupTest(t)
 	require.Nil(t, err)
 
-	uow, err := NewUnitOfWork(db)
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
 	require.Nil(t, err)
 
 	courier1, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
 	assert.Nil(t, err)
+	assert.Nil(t, courier1.StartShift())
 
 	courier2, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
 	assert.Nil(t, err)
+	assert.Nil(t, courier2.StartShift())
+
+	offShift, err := courier.NewCourier("Пешеход", 1, kernel.MaxLocation())
+	assert.Nil(t, err)
 
 	err = uow.CourierRepository().Add(ctx, courier1)
 	assert.Nil(t, err)
@@ -84,20 +106,177 @@ func TestUnitOfWork_CourierRepositoryGetAllFree(t *testing.T) {
 	err = uow.CourierRepository().Add(ctx, courier2)
 	assert.Nil(t, err)
 
+	err = uow.CourierRepository().Add(ctx, offShift)
+	assert.Nil(t, err)
+
 	got, err := uow.CourierRepository().GetAllFree(ctx)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(got))
 }
 
+func TestUnitOfWork_CourierRepositoryGetAllAvailable(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	partiallyLoaded, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
+	require.NoError(t, err)
+	require.NoError(t, partiallyLoaded.StartShift())
+	require.NoError(t, partiallyLoaded.AddStoragePlace("Багажник", 40, 0))
+	require.NoError(t, partiallyLoaded.TakeOrder(order.RestoreOrder(uuid.New(), nil, kernel.MinLocation(), kernel.MinLocation(), 5, order.Details{}, order.PriorityNormal, order.DeliveryWindow{}, false, order.StatusCreated, 0)))
+
+	fullyLoaded, err := courier.NewCourier("Пешеход", 1, kernel.MaxLocation())
+	require.NoError(t, err)
+	require.NoError(t, fullyLoaded.StartShift())
+	require.NoError(t, fullyLoaded.TakeOrder(order.RestoreOrder(uuid.New(), nil, kernel.MinLocation(), kernel.MinLocation(), 5, order.Details{}, order.PriorityNormal, order.DeliveryWindow{}, false, order.StatusCreated, 0)))
+
+	err = uow.CourierRepository().Add(ctx, partiallyLoaded)
+	require.NoError(t, err)
+
+	err = uow.CourierRepository().Add(ctx, fullyLoaded)
+	require.NoError(t, err)
+
+	onBreak, err := courier.NewCourier("Самокатчик", 3, kernel.MaxLocation())
+	require.NoError(t, err)
+	require.NoError(t, onBreak.StartShift())
+	require.NoError(t, onBreak.StartBreak())
+	require.NoError(t, uow.CourierRepository().Add(ctx, onBreak))
+
+	got, err := uow.CourierRepository().GetAllAvailable(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, partiallyLoaded.Id(), got[0].Id())
+}
+
+func TestUnitOfWork_CourierOutsideShrunkGridShouldStillBeRead(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	c, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
+	require.NoError(t, err)
+	require.NoError(t, c.StartShift())
+	require.NoError(t, uow.CourierRepository().Add(ctx, c))
+
+	previous := kernel.CurrentGrid()
+	shrunk, err := kernel.NewGrid(previous.MinX(), previous.MinY(), previous.MaxX()-1, previous.MaxY()-1)
+	require.NoError(t, err)
+	require.NoError(t, kernel.SetGrid(shrunk))
+	t.Cleanup(func() {
+		require.NoError(t, kernel.SetGrid(previous))
+	})
+
+	got, err := uow.CourierRepository().GetAllAvailable(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, previous.MaxLocation(), got[0].Location())
+
+	count, err := courierrepo.CountOutsideGrid(db, shrunk)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+
+	count, err = courierrepo.CountOutsideGrid(db, previous)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+}
+
+func TestUnitOfWork_CourierRepositoryShouldKeepShifts(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	c, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
+	require.NoError(t, err)
+	require.NoError(t, uow.CourierRepository().Add(ctx, c))
+
+	require.NoError(t, c.StartShift())
+	require.NoError(t, uow.CourierRepository().Update(ctx, c))
+
+	onShift, err := uow.CourierRepository().Get(ctx, c.Id())
+	require.NoError(t, err)
+	assert.Equal(t, courier.AvailabilityOnShift, onShift.Availability())
+	require.NotNil(t, onShift.Shift())
+	assert.Equal(t, c.Shift().Id(), onShift.Shift().Id())
+
+	require.NoError(t, onShift.EndShift())
+	require.NoError(t, uow.CourierRepository().Update(ctx, onShift))
+
+	offShift, err := uow.CourierRepository().Get(ctx, c.Id())
+	require.NoError(t, err)
+	assert.Equal(t, courier.AvailabilityOffShift, offShift.Availability())
+	assert.Nil(t, offShift.Shift())
+
+	var shifts []courierrepo.ShiftDTO
+	require.NoError(t, db.Where("courier_id = ?", c.Id()).Find(&shifts).Error)
+	require.Len(t, shifts, 1)
+	assert.NotNil(t, shifts[0].EndedAt)
+}
+
+func TestUnitOfWork_StartShiftForAll(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	offShift, err := courier.NewCourier("Пешеход", 1, kernel.MinLocation())
+	require.NoError(t, err)
+	require.NoError(t, uow.CourierRepository().Add(ctx, offShift))
+
+	onShift, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
+	require.NoError(t, err)
+	require.NoError(t, onShift.StartShift())
+	require.NoError(t, uow.CourierRepository().Add(ctx, onShift))
+
+	require.NoError(t, courierrepo.StartShiftForAll(db, time.Now()))
+
+	started, err := uow.CourierRepository().Get(ctx, offShift.Id())
+	require.NoError(t, err)
+	assert.Equal(t, courier.AvailabilityOnShift, started.Availability())
+	require.NotNil(t, started.Shift())
+
+	// открытая смена не заменяется новой
+	kept, err := uow.CourierRepository().Get(ctx, onShift.Id())
+	require.NoError(t, err)
+	require.NotNil(t, kept.Shift())
+	assert.Equal(t, onShift.Shift().Id(), kept.Shift().Id())
+}
+
+func TestUnitOfWork_CourierRepositoryShouldKeepVehicleAndStoragePlaces(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	c, err := courier.NewCourierWithVehicle("Водитель", courier.VehicleCar, 4, kernel.MinLocation())
+	require.NoError(t, err)
+	require.NoError(t, uow.CourierRepository().Add(ctx, c))
+
+	require.NoError(t, c.AddStoragePlace("Roof box", 40, 0))
+	require.NoError(t, uow.CourierRepository().Update(ctx, c))
+
+	got, err := uow.CourierRepository().Get(ctx, c.Id())
+	require.NoError(t, err)
+	assert.Equal(t, courier.VehicleCar, got.Vehicle())
+	assert.Len(t, got.StoragePlaces(), 3)
+}
+
 func TestUnitOfWork_OrderRepositoryShouldCanAddOrder(t *testing.T) {
 	ctx, db, err := setupTest(t)
 	require.Nil(t, err)
 
-	uow, err := NewUnitOfWork(db)
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
 	require.Nil(t, err)
 
 	location := kernel.MinLocation()
-	orderAggregate, err := order.NewOrder(uuid.New(), location, 10)
+	orderAggregate, err := order.NewOrder(uuid.New(), location, location, 10)
 	err = uow.OrderRepository().Add(ctx, orderAggregate)
 	assert.NoError(t, err)
 
@@ -111,3 +290,596 @@ func TestUnitOfWork_OrderRepositoryShouldCanAddOrder(t *testing.T) {
 	assert.Equal(t, orderAggregate.Volume(), orderFromDb.Volume)
 	assert.Equal(t, orderAggregate.Status(), orderFromDb.Status)
 }
+
+func TestUnitOfWork_OrderRepositoryAddDuplicateShouldReturnAlreadyExists(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	location := kernel.MinLocation()
+	orderID := uuid.New()
+	first, err := order.NewOrder(orderID, location, location, 10)
+	require.NoError(t, err)
+	require.NoError(t, uow.OrderRepository().Add(ctx, first))
+
+	duplicate, err := order.NewOrder(orderID, location, location, 10)
+	require.NoError(t, err)
+	err = uow.OrderRepository().Add(ctx, duplicate)
+	assert.ErrorIs(t, err, errs.ErrObjectAlreadyExists)
+
+	_, err = uow.OrderRepository().Get(ctx, orderID)
+	assert.NoError(t, err)
+}
+
+func TestUnitOfWork_OrderRepositoryShouldKeepDetails(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	dimensions, err := order.NewDimensions(30, 20, 10)
+	require.NoError(t, err)
+	coffee, err := order.NewItem(uuid.New(), "Кофе", 2)
+	require.NoError(t, err)
+	tea, err := order.NewItem(uuid.New(), "Чай", 1)
+	require.NoError(t, err)
+	details, err := order.NewDetails(1500, dimensions, []order.Item{coffee, tea})
+	require.NoError(t, err)
+
+	orderAggregate, err := order.NewOrderWithDetails(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5, details)
+	require.NoError(t, err)
+	require.NoError(t, uow.OrderRepository().Add(ctx, orderAggregate))
+
+	require.NoError(t, orderAggregate.Assign(uuid.New()))
+	require.NoError(t, uow.OrderRepository().Update(ctx, orderAggregate))
+
+	got, err := uow.OrderRepository().Get(ctx, orderAggregate.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 1500, got.Weight())
+	assert.Equal(t, dimensions, got.Details().Dimensions())
+	assert.Equal(t, []order.Item{coffee, tea}, got.Details().Items())
+}
+
+func TestUnitOfWork_OrderRepositoryGetAllInCreatedStatus(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	created1, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 5)
+	require.NoError(t, err)
+	created2, err := order.NewOrder(uuid.New(), kernel.MaxLocation(), kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+	assigned, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 5)
+	require.NoError(t, err)
+	require.NoError(t, assigned.Assign(uuid.New()))
+
+	for _, o := range []*order.Order{created1, created2, assigned} {
+		require.NoError(t, uow.OrderRepository().Add(ctx, o))
+	}
+
+	got, err := uow.OrderRepository().GetAllInCreatedStatus(ctx)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+}
+
+func TestUnitOfWork_BackfillPickupLocation(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	created, err := order.NewOrder(uuid.New(), kernel.MaxLocation(), kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+	assigned, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+	require.NoError(t, assigned.Assign(uuid.New()))
+
+	for _, o := range []*order.Order{created, assigned} {
+		require.NoError(t, uow.OrderRepository().Add(ctx, o))
+	}
+
+	// так выглядят заказы, сохраненные до появления места забора
+	err = db.Exec("UPDATE orders SET pickup_location_x = NULL, pickup_location_y = NULL").Error
+	require.NoError(t, err)
+
+	warehouse := kernel.MinLocation()
+	require.NoError(t, orderrepo.BackfillPickupLocation(db, warehouse))
+
+	storedCreated, err := uow.OrderRepository().Get(ctx, created.ID())
+	require.NoError(t, err)
+	assert.True(t, warehouse.Equals(storedCreated.PickupLocation()))
+
+	storedAssigned, err := uow.OrderRepository().Get(ctx, assigned.ID())
+	require.NoError(t, err)
+	assert.True(t, assigned.Location().Equals(storedAssigned.PickupLocation()))
+}
+
+func TestUnitOfWork_BackfillCurrentStatus(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	withoutHistory, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+	require.NoError(t, withoutHistory.Assign(uuid.New()))
+	withHistory, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+
+	for _, o := range []*order.Order{withoutHistory, withHistory} {
+		require.NoError(t, uow.OrderRepository().Add(ctx, o))
+	}
+
+	createdAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	err = uow.OrderHistoryRepository().Add(ctx, uuid.New(), withHistory.ID(), order.StatusCreated, createdAt)
+	require.NoError(t, err)
+
+	migratedAt := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, orderhistoryrepo.BackfillCurrentStatus(db, migratedAt))
+	// повторный запуск не дублирует строки
+	require.NoError(t, orderhistoryrepo.BackfillCurrentStatus(db, migratedAt))
+
+	var changes []orderhistoryrepo.StatusChangeDTO
+	require.NoError(t, db.Order("changed_at").Find(&changes).Error)
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, withHistory.ID(), changes[0].OrderID)
+	assert.True(t, createdAt.Equal(changes[0].ChangedAt))
+
+	assert.Equal(t, withoutHistory.ID(), changes[1].OrderID)
+	assert.Equal(t, order.StatusAssigned, changes[1].Status)
+	assert.True(t, migratedAt.Equal(changes[1].ChangedAt))
+}
+
+func TestUnitOfWork_OrderRepositoryShouldReturnCreatedOrdersByUrgency(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	createOrder := func(priority order.Priority, deadline time.Duration) *order.Order {
+		o, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5)
+		require.NoError(t, err)
+		require.NoError(t, o.Prioritize(priority))
+		if deadline > 0 {
+			window, err := order.NewDeliveryWindow(now, now.Add(deadline))
+			require.NoError(t, err)
+			require.NoError(t, o.PromiseDeliveryWindow(window))
+		}
+
+		return o
+	}
+
+	low := createOrder(order.PriorityLow, time.Minute)
+	normalWithoutWindow := createOrder(order.PriorityNormal, 0)
+	normalLate := createOrder(order.PriorityNormal, 2*time.Hour)
+	normalSoon := createOrder(order.PriorityNormal, time.Hour)
+	high := createOrder(order.PriorityHigh, 0)
+
+	for _, o := range []*order.Order{low, normalWithoutWindow, normalLate, normalSoon, high} {
+		require.NoError(t, uow.OrderRepository().Add(ctx, o))
+	}
+
+	got, err := uow.OrderRepository().GetAllInCreatedStatus(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 5)
+
+	want := []uuid.UUID{high.ID(), normalSoon.ID(), normalLate.ID(), normalWithoutWindow.ID(), low.ID()}
+	for i, o := range got {
+		assert.Equal(t, want[i], o.ID())
+	}
+	assert.Equal(t, normalSoon.DeliveryWindow().To(), got[1].DeliveryWindow().To().UTC())
+
+	first, err := uow.OrderRepository().GetFirstInCreatedStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, high.ID(), first.ID())
+
+	mostUrgent, err := uow.OrderRepository().GetMostUrgentInCreatedStatus(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, mostUrgent, 3)
+	for i, o := range mostUrgent {
+		assert.Equal(t, want[i], o.ID())
+	}
+}
+
+func TestUnitOfWork_UpdateShouldIncrementVersion(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	c, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
+	require.NoError(t, err)
+	require.NoError(t, uow.CourierRepository().Add(ctx, c))
+
+	o, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 5)
+	require.NoError(t, err)
+	require.NoError(t, uow.OrderRepository().Add(ctx, o))
+
+	require.NoError(t, c.TakeOrder(o))
+	require.NoError(t, o.Assign(c.Id()))
+
+	// Повторное сохранение в рамках одной работы не считается конфликтом
+	for range 2 {
+		require.NoError(t, uow.CourierRepository().Update(ctx, c))
+		require.NoError(t, uow.OrderRepository().Update(ctx, o))
+	}
+
+	storedCourier, err := uow.CourierRepository().Get(ctx, c.Id())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), storedCourier.Version())
+	assert.True(t, storedCourier.HasOrder(o.ID()))
+
+	storedOrder, err := uow.OrderRepository().Get(ctx, o.ID())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), storedOrder.Version())
+}
+
+func TestUnitOfWork_RollbackShouldKeepVersion(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	c, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
+	require.NoError(t, err)
+	require.NoError(t, uow.CourierRepository().Add(ctx, c))
+
+	uow.Begin(ctx)
+	require.NoError(t, c.Move(kernel.MinLocation()))
+	require.NoError(t, uow.CourierRepository().Update(ctx, c))
+	uow.RollbackUnlessCommitted(ctx)
+
+	assert.Equal(t, int64(0), c.Version())
+
+	// после отката агрегат сохраняется повторно без конфликта версий
+	require.NoError(t, uow.CourierRepository().Update(ctx, c))
+	assert.Equal(t, int64(1), c.Version())
+}
+
+func TestUnitOfWork_LostUpdateShouldReturnVersionError(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	seed, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	o, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 5)
+	require.NoError(t, err)
+	require.NoError(t, seed.OrderRepository().Add(ctx, o))
+
+	c, err := courier.NewCourier("Велосипедист", 2, kernel.MaxLocation())
+	require.NoError(t, err)
+	require.NoError(t, seed.CourierRepository().Add(ctx, c))
+
+	first, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+	second, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	firstOrder, err := first.OrderRepository().Get(ctx, o.ID())
+	require.NoError(t, err)
+	secondOrder, err := second.OrderRepository().Get(ctx, o.ID())
+	require.NoError(t, err)
+
+	firstCourier, err := first.CourierRepository().Get(ctx, c.Id())
+	require.NoError(t, err)
+	secondCourier, err := second.CourierRepository().Get(ctx, c.Id())
+	require.NoError(t, err)
+
+	require.NoError(t, firstOrder.Assign(c.Id()))
+	require.NoError(t, first.OrderRepository().Update(ctx, firstOrder))
+	require.NoError(t, firstCourier.Move(kernel.MinLocation()))
+	require.NoError(t, first.CourierRepository().Update(ctx, firstCourier))
+
+	require.NoError(t, secondOrder.Assign(uuid.New()))
+	err = second.OrderRepository().Update(ctx, secondOrder)
+	assert.ErrorIs(t, err, errs.ErrVersionIsInvalid)
+
+	require.NoError(t, secondCourier.Move(kernel.MinLocation()))
+	err = second.CourierRepository().Update(ctx, secondCourier)
+	assert.ErrorIs(t, err, errs.ErrVersionIsInvalid)
+
+	stored, err := seed.OrderRepository().Get(ctx, o.ID())
+	require.NoError(t, err)
+	assert.Equal(t, c.Id(), *stored.CourierID())
+}
+
+func TestUnitOfWork_OrderRepositoryShouldExcludeCanceledOrders(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	canceledCreated, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 5)
+	require.NoError(t, err)
+	require.NoError(t, canceledCreated.Cancel())
+
+	canceledAssigned, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 5)
+	require.NoError(t, err)
+	require.NoError(t, canceledAssigned.Assign(uuid.New()))
+	require.NoError(t, canceledAssigned.Cancel())
+
+	for _, o := range []*order.Order{canceledCreated, canceledAssigned} {
+		require.NoError(t, uow.OrderRepository().Add(ctx, o))
+	}
+
+	_, err = uow.OrderRepository().GetFirstInCreatedStatus(ctx)
+	assert.ErrorIs(t, err, errs.ErrObjectNotFound)
+
+	assigned, err := uow.OrderRepository().GetAllInAssignedStatus(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, assigned)
+}
+
+func TestUnitOfWork_OrderRepositoryShouldReturnOrdersInDelivery(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	pickup, err := kernel.NewLocation(2, 3)
+	require.NoError(t, err)
+
+	created, err := order.NewOrder(uuid.New(), pickup, kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+
+	assigned, err := order.NewOrder(uuid.New(), pickup, kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+	require.NoError(t, assigned.Assign(uuid.New()))
+
+	pickedUp, err := order.NewOrder(uuid.New(), pickup, kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+	require.NoError(t, pickedUp.Assign(uuid.New()))
+	require.NoError(t, pickedUp.PickUp())
+
+	for _, o := range []*order.Order{created, assigned, pickedUp} {
+		require.NoError(t, uow.OrderRepository().Add(ctx, o))
+	}
+
+	inDelivery, err := uow.OrderRepository().GetAllInDelivery(ctx)
+	require.NoError(t, err)
+	require.Len(t, inDelivery, 2)
+
+	ids := []uuid.UUID{inDelivery[0].ID(), inDelivery[1].ID()}
+	assert.ElementsMatch(t, []uuid.UUID{assigned.ID(), pickedUp.ID()}, ids)
+	for _, o := range inDelivery {
+		assert.Equal(t, pickup, o.PickupLocation())
+		assert.Equal(t, kernel.MaxLocation(), o.Location())
+	}
+}
+
+func TestUnitOfWork_CommitShouldSaveSubscribedDomainEventsToOutbox(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, newOutboxMediatr())
+	require.Nil(t, err)
+
+	orderAggregate, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 10)
+	require.NoError(t, err)
+	orderAggregate.ClearDomainEvents()
+	event := testDomainEvent{ID: uuid.New()}
+	orderAggregate.RaiseDomainEvent(event)
+	// событие без подписки в outbox не попадает
+	orderAggregate.RaiseDomainEvent(courier.CourierMovedDomainEvent{})
+
+	err = uow.OrderRepository().Add(ctx, orderAggregate)
+	require.NoError(t, err)
+	assert.Empty(t, orderAggregate.GetDomainEvents())
+
+	var messages []outbox.Message
+	err = db.Find(&messages).Error
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, event.ID, messages[0].ID)
+	assert.Equal(t, event.GetName(), messages[0].Name)
+	assert.Nil(t, messages[0].ProcessedAtUtc)
+
+	uow.Begin(ctx)
+	notPublished, err := uow.OutboxRepository().GetNotPublishedMessages(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, notPublished, 1)
+	uow.RollbackUnlessCommitted(ctx)
+}
+
+func TestUnitOfWork_OutboxShouldReturnDeferredMessagesInOrder(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, ddd.NewMediatr())
+	require.Nil(t, err)
+
+	now := time.Now().UTC()
+	deferred := &outbox.Message{ID: uuid.New(), Name: "deferred", OccurredAtUtc: now.Add(-time.Minute)}
+	deferred.MarkFailed(assert.AnError, now)
+	ready := &outbox.Message{ID: uuid.New(), Name: "ready", OccurredAtUtc: now}
+	published := &outbox.Message{ID: uuid.New(), Name: "published", OccurredAtUtc: now}
+	published.MarkProcessed(now)
+	for _, message := range []*outbox.Message{ready, deferred, published} {
+		require.NoError(t, uow.OutboxRepository().Add(ctx, message))
+	}
+
+	// отложенное сообщение возвращается первым, чтобы задача не опубликовала следующие раньше него
+	notPublished, err := uow.OutboxRepository().GetNotPublishedMessages(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, notPublished, 2)
+	assert.Equal(t, deferred.ID, notPublished[0].ID)
+	assert.Equal(t, ready.ID, notPublished[1].ID)
+}
+
+func TestUnitOfWork_RollbackShouldNotSaveDomainEventsToOutbox(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	uow, err := NewUnitOfWork(db, newOutboxMediatr())
+	require.Nil(t, err)
+
+	orderAggregate, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 10)
+	require.NoError(t, err)
+	orderAggregate.RaiseDomainEvent(testDomainEvent{ID: uuid.New()})
+
+	uow.Begin(ctx)
+	err = uow.OrderRepository().Add(ctx, orderAggregate)
+	require.NoError(t, err)
+	uow.RollbackUnlessCommitted(ctx)
+
+	var count int64
+	err = db.Model(&outbox.Message{}).Count(&count).Error
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func newOutboxMediatr() ddd.Mediatr {
+	mediatr := ddd.NewMediatr()
+	mediatr.SubscribePreCommit(eventhandlers.NewOutboxHandler(), testDomainEvent{})
+	return mediatr
+}
+
+type testDomainEvent struct {
+	ID uuid.UUID
+}
+
+func (e testDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e testDomainEvent) GetName() string {
+	return "testDomainEvent"
+}
+
+func TestUnitOfWork_CommitShouldPublishDomainEvents(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	mediatr := ddd.NewMediatr()
+	handler := &testEventHandler{}
+	preCommitHandler := &testEventHandler{}
+	mediatr.Subscribe(handler, order.OrderCreatedDomainEvent{})
+	mediatr.SubscribePreCommit(preCommitHandler, order.OrderCreatedDomainEvent{})
+
+	uow, err := NewUnitOfWork(db, mediatr)
+	require.Nil(t, err)
+
+	orderAggregate, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 10)
+	require.NoError(t, err)
+
+	err = uow.OrderRepository().Add(ctx, orderAggregate)
+	require.NoError(t, err)
+
+	require.Len(t, preCommitHandler.events, 1)
+	assert.True(t, preCommitHandler.inTx)
+	require.Len(t, handler.events, 1)
+	assert.False(t, handler.inTx)
+	assert.Equal(t, orderAggregate.ID(), handler.events[0].(order.OrderCreatedDomainEvent).OrderID)
+}
+
+func TestUnitOfWork_CommitShouldRecordOrderStatusHistory(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	mediatr := ddd.NewMediatr()
+	mediatr.SubscribePreCommit(eventhandlers.NewOrderStatusHistoryHandler(), eventhandlers.OrderStatusChangedEvents...)
+
+	uow, err := NewUnitOfWork(db, mediatr)
+	require.Nil(t, err)
+
+	orderAggregate, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 10)
+	require.NoError(t, err)
+	require.NoError(t, uow.OrderRepository().Add(ctx, orderAggregate))
+
+	courierID := uuid.New()
+	uow.Begin(ctx)
+	require.NoError(t, orderAggregate.Assign(courierID))
+	require.NoError(t, orderAggregate.PickUp())
+	require.NoError(t, uow.OrderRepository().Update(ctx, orderAggregate))
+	require.NoError(t, uow.Commit(ctx))
+
+	queryHandler, err := queries.NewGetOrderQueryHandler(db)
+	require.NoError(t, err)
+	query, err := queries.NewGetOrderQuery(orderAggregate.ID())
+	require.NoError(t, err)
+
+	got, err := queryHandler.Handle(ctx, query)
+	require.NoError(t, err)
+	assert.Equal(t, orderAggregate.ID(), got.ID)
+	assert.Equal(t, order.StatusPickedUp.String(), got.Status)
+	assert.Equal(t, 10, got.Volume)
+	assert.Equal(t, &courierID, got.CourierID)
+	assert.Equal(t, queries.LocationResponse{X: kernel.MaxLocation().X(), Y: kernel.MaxLocation().Y()}, got.Location)
+
+	statuses := make([]string, 0, len(got.History))
+	for _, change := range got.History {
+		statuses = append(statuses, change.Status)
+	}
+	assert.Equal(t, []string{
+		order.StatusCreated.String(),
+		order.StatusAssigned.String(),
+		order.StatusPickedUp.String(),
+	}, statuses)
+
+	query, err = queries.NewGetOrderQuery(uuid.New())
+	require.NoError(t, err)
+	_, err = queryHandler.Handle(ctx, query)
+	assert.ErrorIs(t, err, errs.ErrObjectNotFound)
+}
+
+func TestUnitOfWork_PreCommitHandlerErrorShouldRollback(t *testing.T) {
+	ctx, db, err := setupTest(t)
+	require.Nil(t, err)
+
+	mediatr := ddd.NewMediatr()
+	handler := &testEventHandler{}
+	mediatr.Subscribe(handler, order.OrderCreatedDomainEvent{})
+	mediatr.SubscribePreCommit(&testEventHandler{err: assert.AnError}, order.OrderCreatedDomainEvent{})
+
+	uow, err := NewUnitOfWork(db, mediatr)
+	require.Nil(t, err)
+
+	orderAggregate, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 10)
+	require.NoError(t, err)
+
+	uow.Begin(ctx)
+	err = uow.OrderRepository().Add(ctx, orderAggregate)
+	require.NoError(t, err)
+	err = uow.Commit(ctx)
+	require.ErrorIs(t, err, assert.AnError)
+	uow.RollbackUnlessCommitted(ctx)
+
+	assert.Empty(t, handler.events)
+
+	var count int64
+	err = db.Model(&orderrepo.OrderDTO{}).Count(&count).Error
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+type testEventHandler struct {
+	events []ddd.DomainEvent
+	inTx   bool
+	err    error
+}
+
+func (h *testEventHandler) Handle(ctx context.Context, event ddd.DomainEvent) error {
+	if h.err != nil {
+		return h.err
+	}
+
+	uow, ok := ports.UnitOfWorkFromContext(ctx)
+	h.inTx = ok && uow.(*UnitOfWork).InTx()
+	h.events = append(h.events, event)
+	return nil
+}
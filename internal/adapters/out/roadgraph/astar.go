@@ -0,0 +1,100 @@
+package roadgraph
+
+import (
+	"container/heap"
+	"delivery/internal/core/domain/model/kernel"
+	"slices"
+)
+
+// shortestPath ищет кратчайший путь между вершинами графа алгоритмом A*.
+// Эвристика - манхэттенское расстояние, умноженное на минимальную стоимость клетки,
+// поэтому она не переоценивает остаток пути
+func (g *Graph) shortestPath(from, to kernel.Location) ([]kernel.Location, []float64, bool) {
+	heuristic := func(l kernel.Location) float64 {
+		return float64(l.DistanceTo(to)) * g.minCostPerCell
+	}
+
+	cost := map[kernel.Location]float64{from: 0}
+	cameFrom := make(map[kernel.Location]edge)
+	closed := make(map[kernel.Location]bool)
+
+	open := &priorityQueue{}
+	heap.Push(open, &queueItem{node: from, priority: heuristic(from)})
+
+	for open.Len() > 0 {
+		node := heap.Pop(open).(*queueItem).node
+		if node.Equals(to) {
+			return g.restorePath(from, to, cameFrom)
+		}
+
+		if closed[node] {
+			continue
+		}
+		closed[node] = true
+
+		for _, e := range g.adjacency[node] {
+			if closed[e.to] {
+				continue
+			}
+
+			candidate := cost[node] + e.weight
+			if known, ok := cost[e.to]; ok && known <= candidate {
+				continue
+			}
+
+			cost[e.to] = candidate
+			cameFrom[e.to] = edge{to: node, weight: e.weight}
+			heap.Push(open, &queueItem{node: e.to, priority: candidate + heuristic(e.to)})
+		}
+	}
+
+	return nil, nil, false
+}
+
+// restorePath возвращает вершины пути и стоимость каждого его ребра
+func (g *Graph) restorePath(from, to kernel.Location, cameFrom map[kernel.Location]edge) ([]kernel.Location, []float64, bool) {
+	path := []kernel.Location{to}
+	costs := make([]float64, 0)
+	for current := to; !current.Equals(from); {
+		previous := cameFrom[current]
+		path = append(path, previous.to)
+		costs = append(costs, previous.weight)
+		current = previous.to
+	}
+
+	slices.Reverse(path)
+	slices.Reverse(costs)
+
+	return path, costs, true
+}
+
+type queueItem struct {
+	node     kernel.Location
+	priority float64
+}
+
+type priorityQueue []*queueItem
+
+func (q priorityQueue) Len() int {
+	return len(q)
+}
+
+func (q priorityQueue) Less(i, j int) bool {
+	return q[i].priority < q[j].priority
+}
+
+func (q priorityQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *priorityQueue) Push(x any) {
+	*q = append(*q, x.(*queueItem))
+}
+
+func (q *priorityQueue) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
@@ -0,0 +1,211 @@
+package roadgraph
+
+import (
+	"bufio"
+	"cmp"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var ErrGraphNotConnected = errors.New("road graph is not connected")
+
+type edge struct {
+	to     kernel.Location
+	weight float64
+}
+
+// Graph - дорожная сеть: вершины - клетки карты, ребра - участки дорог
+type Graph struct {
+	adjacency map[kernel.Location][]edge
+	// nodes отсортированы, чтобы поиск ближайшей вершины не зависел от порядка обхода map
+	nodes []kernel.Location
+	// minCostPerCell - минимальная стоимость ребра на клетку, нужна для допустимой эвристики A*
+	minCostPerCell float64
+}
+
+// LoadFile загружает дорожную сеть из файла со списком ребер, см. Parse
+func LoadFile(path string) (*Graph, error) {
+	if path == "" {
+		return nil, errs.NewValueIsRequiredError("path")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// Parse читает дорожную сеть: по одному ребру на строке в формате "x1 y1 x2 y2 [weight]".
+// Ребра двусторонние, вес по умолчанию равен манхэттенскому расстоянию между концами.
+// Горизонтальные и вертикальные ребра разбиваются на участки длиной в одну клетку,
+// поэтому улицу можно задать одной строкой. Пустые строки и строки, начинающиеся с #, пропускаются
+func Parse(r io.Reader) (*Graph, error) {
+	g := &Graph{
+		adjacency:      make(map[kernel.Location][]edge),
+		minCostPerCell: math.MaxFloat64,
+	}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		err := g.parseEdge(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(g.adjacency) == 0 {
+		return nil, errs.NewValueIsRequiredError("edges")
+	}
+
+	g.nodes = make([]kernel.Location, 0, len(g.adjacency))
+	for node := range g.adjacency {
+		g.nodes = append(g.nodes, node)
+	}
+	slices.SortFunc(g.nodes, func(a, b kernel.Location) int {
+		return cmp.Or(cmp.Compare(a.X(), b.X()), cmp.Compare(a.Y(), b.Y()))
+	})
+
+	if !g.isConnected() {
+		return nil, ErrGraphNotConnected
+	}
+
+	return g, nil
+}
+
+func (g *Graph) parseEdge(text string) error {
+	fields := strings.Fields(text)
+	if len(fields) != 4 && len(fields) != 5 {
+		return errs.NewValueIsInvalidError("edge")
+	}
+
+	coordinates := make([]int, 4)
+	for i := range coordinates {
+		value, err := strconv.Atoi(fields[i])
+		if err != nil {
+			return errs.NewValueIsInvalidError("coordinate")
+		}
+		coordinates[i] = value
+	}
+
+	from, err := kernel.NewLocation(coordinates[0], coordinates[1])
+	if err != nil {
+		return err
+	}
+
+	to, err := kernel.NewLocation(coordinates[2], coordinates[3])
+	if err != nil {
+		return err
+	}
+
+	length := from.DistanceTo(to)
+	if length == 0 {
+		return errs.NewValueIsInvalidError("edge")
+	}
+
+	weight := float64(length)
+	if len(fields) == 5 {
+		weight, err = strconv.ParseFloat(fields[4], 64)
+		if err != nil || math.IsNaN(weight) || math.IsInf(weight, 0) || weight <= 0 {
+			return errs.NewValueIsInvalidError("weight")
+		}
+	}
+
+	g.minCostPerCell = min(g.minCostPerCell, weight/float64(length))
+
+	if from.X() != to.X() && from.Y() != to.Y() {
+		g.addEdge(from, to, weight)
+		return nil
+	}
+
+	stepX, stepY := sign(to.X()-from.X()), sign(to.Y()-from.Y())
+	current := from
+	for !current.Equals(to) {
+		next, err := kernel.NewLocation(current.X()+stepX, current.Y()+stepY)
+		if err != nil {
+			return err
+		}
+
+		g.addEdge(current, next, weight/float64(length))
+		current = next
+	}
+
+	return nil
+}
+
+func (g *Graph) addEdge(a, b kernel.Location, weight float64) {
+	g.adjacency[a] = append(g.adjacency[a], edge{to: b, weight: weight})
+	g.adjacency[b] = append(g.adjacency[b], edge{to: a, weight: weight})
+}
+
+func (g *Graph) hasNode(l kernel.Location) bool {
+	_, ok := g.adjacency[l]
+	return ok
+}
+
+// nearestNode возвращает ближайшую к клетке вершину графа
+func (g *Graph) nearestNode(l kernel.Location) kernel.Location {
+	if g.hasNode(l) {
+		return l
+	}
+
+	nearest := g.nodes[0]
+	for _, node := range g.nodes[1:] {
+		if l.DistanceTo(node) < l.DistanceTo(nearest) {
+			nearest = node
+		}
+	}
+
+	return nearest
+}
+
+func (g *Graph) isConnected() bool {
+	visited := map[kernel.Location]bool{g.nodes[0]: true}
+	queue := []kernel.Location{g.nodes[0]}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, e := range g.adjacency[node] {
+			if !visited[e.to] {
+				visited[e.to] = true
+				queue = append(queue, e.to)
+			}
+		}
+	}
+
+	return len(visited) == len(g.nodes)
+}
+
+func sign(v int) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	default:
+		return 0
+	}
+}
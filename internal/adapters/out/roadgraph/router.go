@@ -0,0 +1,189 @@
+package roadgraph
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+var ErrNoRoute = errors.New("no route")
+
+var _ kernel.DistanceMetric = &Router{}
+
+// Router прокладывает маршруты по дорожной сети и служит метрикой расстояния.
+// Клетки вне дорог соединяются с ближайшей вершиной графа подъездом по прямой.
+// Расстояние измеряется в клетках, скорость курьера - в клетках за шаг, как и на сетке без дорог
+type Router struct {
+	graph *Graph
+
+	// Distance вызывается для каждой пары курьер-заказ на каждом шаге, а граф не меняется,
+	// поэтому ближайшие вершины и пути между вершинами считаются один раз
+	mu      sync.RWMutex
+	nearest map[kernel.Location]kernel.Location
+	paths   map[nodePair]nodePath
+}
+
+type nodePair struct {
+	from kernel.Location
+	to   kernel.Location
+}
+
+type nodePath struct {
+	nodes []kernel.Location
+	costs []float64
+	ok    bool
+}
+
+func NewRouter(graph *Graph) (*Router, error) {
+	if graph == nil {
+		return nil, errs.NewValueIsRequiredError("graph")
+	}
+
+	return &Router{
+		graph:   graph,
+		nearest: make(map[kernel.Location]kernel.Location),
+		paths:   make(map[nodePair]nodePath),
+	}, nil
+}
+
+func (r *Router) Route(from, to kernel.Location) ([]kernel.Location, error) {
+	path, _, err := r.route(from, to)
+	return path, err
+}
+
+// Distance возвращает длину маршрута или +Inf, если маршрут построить нельзя
+func (r *Router) Distance(from, to kernel.Location) float64 {
+	_, costs, err := r.route(from, to)
+	if err != nil {
+		return math.Inf(1)
+	}
+
+	d := 0.0
+	for _, c := range costs {
+		d += c
+	}
+
+	return d
+}
+
+// Advance проходит по маршруту расстояние distance. На участке, который не удается пройти
+// целиком, курьер движется по прямой пропорционально пройденной доле
+func (r *Router) Advance(from, to kernel.Location, distance float64) (kernel.Location, error) {
+	if from.Equals(to) || distance <= 0 {
+		return from, nil
+	}
+
+	path, costs, err := r.route(from, to)
+	if err != nil {
+		return kernel.Location{}, err
+	}
+
+	grid := kernel.NewManhattanMetric()
+	current := path[0]
+	remaining := distance
+	for i, cost := range costs {
+		if remaining < cost {
+			cells := remaining * float64(path[i].DistanceTo(path[i+1])) / cost
+			current, err = grid.Advance(path[i], path[i+1], cells)
+			if err != nil {
+				return kernel.Location{}, err
+			}
+			break
+		}
+
+		remaining -= cost
+		current = path[i+1]
+	}
+
+	return current, nil
+}
+
+func (r *Router) TravelDistance(speed int, _ time.Duration) float64 {
+	return float64(speed)
+}
+
+func (r *Router) TravelTime(speed int, distance float64) time.Duration {
+	return kernel.StepsTravelTime(speed, distance)
+}
+
+// route возвращает точки маршрута и стоимость каждого участка между ними
+func (r *Router) route(from, to kernel.Location) ([]kernel.Location, []float64, error) {
+	if !from.IsValid() {
+		return nil, nil, errs.NewValueIsInvalidError("from")
+	}
+
+	if !to.IsValid() {
+		return nil, nil, errs.NewValueIsInvalidError("to")
+	}
+
+	if from.Equals(to) {
+		return []kernel.Location{from}, []float64{}, nil
+	}
+
+	entry := r.nearestNode(from)
+	exit := r.nearestNode(to)
+
+	nodes, edgeCosts, ok := r.shortestPath(entry, exit)
+	if !ok {
+		return nil, nil, ErrNoRoute
+	}
+
+	path := make([]kernel.Location, 0, len(nodes)+2)
+	costs := make([]float64, 0, len(edgeCosts)+2)
+
+	if !from.Equals(entry) {
+		path = append(path, from)
+		costs = append(costs, float64(from.DistanceTo(entry)))
+	}
+
+	path = append(path, nodes...)
+	costs = append(costs, edgeCosts...)
+
+	if !to.Equals(exit) {
+		path = append(path, to)
+		costs = append(costs, float64(exit.DistanceTo(to)))
+	}
+
+	return path, costs, nil
+}
+
+func (r *Router) nearestNode(l kernel.Location) kernel.Location {
+	r.mu.RLock()
+	node, ok := r.nearest[l]
+	r.mu.RUnlock()
+	if ok {
+		return node
+	}
+
+	node = r.graph.nearestNode(l)
+
+	r.mu.Lock()
+	r.nearest[l] = node
+	r.mu.Unlock()
+
+	return node
+}
+
+// shortestPath возвращает общие для всех вызовов срезы, вызывающий их не изменяет
+func (r *Router) shortestPath(from, to kernel.Location) ([]kernel.Location, []float64, bool) {
+	key := nodePair{from: from, to: to}
+
+	r.mu.RLock()
+	path, ok := r.paths[key]
+	r.mu.RUnlock()
+	if ok {
+		return path.nodes, path.costs, path.ok
+	}
+
+	nodes, costs, found := r.graph.shortestPath(from, to)
+	path = nodePath{nodes: nodes, costs: costs, ok: found}
+
+	r.mu.Lock()
+	r.paths[key] = path
+	r.mu.Unlock()
+
+	return path.nodes, path.costs, path.ok
+}
@@ -0,0 +1,205 @@
+package roadgraph_test
+
+import (
+	"delivery/internal/adapters/out/roadgraph"
+	"delivery/internal/core/domain/model/kernel"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// U-образная дорога: из (1,1) в (5,1) нельзя проехать напрямую
+const uShapedRoad = `
+# west side
+1 1 1 5
+1 5 5 5
+5 5 5 1
+`
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "Empty graph", input: "# nothing here\n"},
+		{name: "Not enough fields", input: "1 1 1\n"},
+		{name: "Not a number", input: "1 1 a 1\n"},
+		{name: "Out of grid", input: "1 1 1 100\n"},
+		{name: "Loop edge", input: "1 1 1 1\n"},
+		{name: "Negative weight", input: "1 1 1 2 -1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := roadgraph.Parse(strings.NewReader(tt.input))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParse_Disconnected(t *testing.T) {
+	_, err := roadgraph.Parse(strings.NewReader("1 1 1 2\n5 5 5 6\n"))
+	assert.ErrorIs(t, err, roadgraph.ErrGraphNotConnected)
+}
+
+func TestLoadFile_Sample(t *testing.T) {
+	graph, err := roadgraph.LoadFile("../../../../configs/road_graph.txt")
+	require.NoError(t, err)
+
+	router, err := roadgraph.NewRouter(graph)
+	require.NoError(t, err)
+	assert.Equal(t, 18.0, router.Distance(kernel.MinLocation(), kernel.MaxLocation()))
+}
+
+func TestRouter_Route(t *testing.T) {
+	router := createRouter(t, uShapedRoad)
+
+	path, err := router.Route(location(1, 1), location(5, 1))
+	require.NoError(t, err)
+	assert.Len(t, path, 13)
+	assert.Equal(t, location(1, 1), path[0])
+	assert.Equal(t, location(1, 5), path[4])
+	assert.Equal(t, location(5, 5), path[8])
+	assert.Equal(t, location(5, 1), path[12])
+
+	assert.Equal(t, 12.0, router.Distance(location(1, 1), location(5, 1)))
+	assert.Equal(t, 0.0, router.Distance(location(1, 1), location(1, 1)))
+}
+
+func TestRouter_Route_PrefersCheaperEdges(t *testing.T) {
+	tests := []struct {
+		name     string
+		shortcut string
+		want     float64
+	}{
+		{name: "Expensive shortcut", shortcut: "1 1 5 1 20", want: 12},
+		{name: "Cheap shortcut", shortcut: "1 1 5 1 8", want: 8},
+		{name: "Diagonal shortcut", shortcut: "1 5 5 1", want: 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := createRouter(t, uShapedRoad+tt.shortcut+"\n")
+
+			assert.Equal(t, tt.want, router.Distance(location(1, 1), location(5, 1)))
+		})
+	}
+}
+
+func TestRouter_Route_OffRoadLocations(t *testing.T) {
+	router := createRouter(t, uShapedRoad)
+
+	// (2,2) подключается к ближайшей вершине (1,2), (6,1) - к (5,1)
+	path, err := router.Route(location(2, 2), location(6, 1))
+	require.NoError(t, err)
+	assert.Equal(t, location(2, 2), path[0])
+	assert.Equal(t, location(1, 2), path[1])
+	assert.Equal(t, location(5, 1), path[len(path)-2])
+	assert.Equal(t, location(6, 1), path[len(path)-1])
+
+	assert.Equal(t, 1.0+11.0+1.0, router.Distance(location(2, 2), location(6, 1)))
+}
+
+func TestRouter_CachedRoutesAreNotShared(t *testing.T) {
+	router := createRouter(t, uShapedRoad)
+
+	first, err := router.Route(location(1, 1), location(5, 1))
+	require.NoError(t, err)
+	first[1] = location(9, 9)
+
+	// повторный маршрут берется из кеша, но изменения прежнего результата на него не влияют
+	second, err := router.Route(location(1, 1), location(5, 1))
+	require.NoError(t, err)
+	assert.Equal(t, location(1, 2), second[1])
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 12.0, router.Distance(location(1, 1), location(5, 1)))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRouter_Advance(t *testing.T) {
+	router := createRouter(t, uShapedRoad)
+
+	tests := []struct {
+		name     string
+		distance float64
+		want     kernel.Location
+	}{
+		{name: "Along the road", distance: 6, want: location(3, 5)},
+		{name: "Whole route", distance: 100, want: location(5, 1)},
+		{name: "Less than a cell", distance: 0.5, want: location(1, 1)},
+		{name: "No distance", distance: 0, want: location(1, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := router.Advance(location(1, 1), location(5, 1), tt.distance)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRouter_Advance_SlowEdge(t *testing.T) {
+	// участок (1,1)-(1,2) стоит 4, за шаг длиной 2 курьер проезжает только его половину
+	// и остается в клетке, пройденный путь накапливает сам курьер
+	router := createRouter(t, "1 1 1 2 4\n1 2 1 5\n")
+
+	got, err := router.Advance(location(1, 1), location(1, 5), 2)
+	require.NoError(t, err)
+	assert.Equal(t, location(1, 1), got)
+
+	got, err = router.Advance(location(1, 1), location(1, 5), 5)
+	require.NoError(t, err)
+	assert.Equal(t, location(1, 3), got)
+}
+
+func TestRouter_MovesCourierAlongRoad(t *testing.T) {
+	router := createRouter(t, uShapedRoad)
+
+	previous := kernel.CurrentDistanceMetric()
+	require.NoError(t, kernel.SetDistanceMetric(router))
+	t.Cleanup(func() {
+		require.NoError(t, kernel.SetDistanceMetric(previous))
+	})
+
+	c := location(1, 1)
+	visited := []kernel.Location{c}
+	for !c.Equals(location(5, 1)) {
+		next, err := kernel.CurrentDistanceMetric().Advance(c, location(5, 1), 3)
+		require.NoError(t, err)
+		c = next
+		visited = append(visited, c)
+	}
+
+	assert.Equal(t, []kernel.Location{
+		location(1, 1), location(1, 4), location(3, 5), location(5, 4), location(5, 1),
+	}, visited)
+}
+
+func createRouter(t *testing.T, input string) *roadgraph.Router {
+	t.Helper()
+
+	graph, err := roadgraph.Parse(strings.NewReader(input))
+	require.NoError(t, err)
+
+	router, err := roadgraph.NewRouter(graph)
+	require.NoError(t, err)
+
+	return router
+}
+
+func location(x, y int) kernel.Location {
+	l, err := kernel.NewLocation(x, y)
+	if err != nil {
+		panic(err)
+	}
+
+	return l
+}
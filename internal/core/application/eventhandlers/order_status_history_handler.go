@@ -0,0 +1,67 @@
+package eventhandlers
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// orderStatusChangedEvent - доменное событие, меняющее статус заказа
+type orderStatusChangedEvent interface {
+	ddd.DomainEvent
+	GetOrderID() uuid.UUID
+	GetOrderStatus() order.Status
+	GetOccurredAt() time.Time
+}
+
+var (
+	_ orderStatusChangedEvent = order.OrderCreatedDomainEvent{}
+	_ orderStatusChangedEvent = order.OrderAssignedDomainEvent{}
+	_ orderStatusChangedEvent = order.OrderPickedUpDomainEvent{}
+	_ orderStatusChangedEvent = order.OrderCompletedDomainEvent{}
+	_ orderStatusChangedEvent = order.OrderCanceledDomainEvent{}
+)
+
+// OrderStatusChangedEvents - события, которые нужно передать в OrderStatusHistoryHandler.
+// Они же публикуются в Kafka через outbox
+var OrderStatusChangedEvents = []ddd.DomainEvent{
+	order.OrderCreatedDomainEvent{},
+	order.OrderAssignedDomainEvent{},
+	order.OrderPickedUpDomainEvent{},
+	order.OrderCompletedDomainEvent{},
+	order.OrderCanceledDomainEvent{},
+}
+
+var _ ddd.EventHandler = &orderStatusHistoryHandler{}
+
+// orderStatusHistoryHandler записывает смену статуса заказа в историю.
+// Подписывается через SubscribePreCommit: история сохраняется в той же транзакции, что и заказ
+type orderStatusHistoryHandler struct{}
+
+func NewOrderStatusHistoryHandler() ddd.EventHandler {
+	return &orderStatusHistoryHandler{}
+}
+
+func (h *orderStatusHistoryHandler) Handle(ctx context.Context, domainEvent ddd.DomainEvent) error {
+	if domainEvent == nil {
+		return errs.NewValueIsRequiredError("domainEvent")
+	}
+
+	event, ok := domainEvent.(orderStatusChangedEvent)
+	if !ok {
+		return nil
+	}
+
+	uow, ok := ports.UnitOfWorkFromContext(ctx)
+	if !ok {
+		return errs.NewValueIsRequiredError("unitOfWork")
+	}
+
+	return uow.OrderHistoryRepository().Add(
+		ctx, event.GetID(), event.GetOrderID(), event.GetOrderStatus(), event.GetOccurredAt())
+}
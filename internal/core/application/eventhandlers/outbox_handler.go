@@ -0,0 +1,38 @@
+package eventhandlers
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/outbox"
+)
+
+var _ ddd.EventHandler = &outboxHandler{}
+
+// outboxHandler сохраняет событие в outbox, откуда его публикует OutboxJob.
+// Подписывается через SubscribePreCommit и только на события, у которых есть получатель:
+// сообщение сохраняется в той же транзакции, что и агрегат
+type outboxHandler struct{}
+
+func NewOutboxHandler() ddd.EventHandler {
+	return &outboxHandler{}
+}
+
+func (h *outboxHandler) Handle(ctx context.Context, domainEvent ddd.DomainEvent) error {
+	if domainEvent == nil {
+		return errs.NewValueIsRequiredError("domainEvent")
+	}
+
+	uow, ok := ports.UnitOfWorkFromContext(ctx)
+	if !ok {
+		return errs.NewValueIsRequiredError("unitOfWork")
+	}
+
+	message, err := outbox.EncodeDomainEvent(domainEvent)
+	if err != nil {
+		return err
+	}
+
+	return uow.OutboxRepository().Add(ctx, &message)
+}
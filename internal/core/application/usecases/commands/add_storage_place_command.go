@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type AddStoragePlaceCommand struct {
+	courierID   uuid.UUID
+	name        string
+	totalVolume int
+	maxWeight   int
+
+	isValid bool
+}
+
+func (c AddStoragePlaceCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c AddStoragePlaceCommand) Name() string {
+	return c.name
+}
+
+func (c AddStoragePlaceCommand) TotalVolume() int {
+	return c.totalVolume
+}
+
+func (c AddStoragePlaceCommand) MaxWeight() int {
+	return c.maxWeight
+}
+
+func (c AddStoragePlaceCommand) IsValid() bool {
+	return c.isValid
+}
+
+// NewAddStoragePlaceCommand создает команду добавления места хранения. Если maxWeight равен 0,
+// вес заказа не ограничивается
+func NewAddStoragePlaceCommand(courierID uuid.UUID, name string, totalVolume int, maxWeight int) (AddStoragePlaceCommand, error) {
+	if courierID == uuid.Nil {
+		return AddStoragePlaceCommand{}, errs.NewValueIsInvalidError("courierID")
+	}
+
+	if name == "" {
+		return AddStoragePlaceCommand{}, errs.NewValueIsInvalidError("name")
+	}
+
+	if totalVolume <= 0 {
+		return AddStoragePlaceCommand{}, errs.NewValueIsInvalidError("totalVolume")
+	}
+
+	if maxWeight < 0 {
+		return AddStoragePlaceCommand{}, errs.NewValueIsInvalidError("maxWeight")
+	}
+
+	return AddStoragePlaceCommand{
+		courierID:   courierID,
+		name:        name,
+		totalVolume: totalVolume,
+		maxWeight:   maxWeight,
+		isValid:     true,
+	}, nil
+}
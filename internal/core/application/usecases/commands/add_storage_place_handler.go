@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type AddStoragePlaceCommandHandler interface {
+	Handle(ctx context.Context, command AddStoragePlaceCommand) error
+}
+
+var _ AddStoragePlaceCommandHandler = &addStoragePlaceCommandHandler{}
+
+type addStoragePlaceCommandHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+func NewAddStoragePlaceCommandHandler(uowFactory ports.UnitOfWorkFactory) (AddStoragePlaceCommandHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsRequiredError("uowFactory")
+	}
+
+	return addStoragePlaceCommandHandler{
+		uowFactory: uowFactory,
+	}, nil
+}
+
+func (h addStoragePlaceCommandHandler) Handle(ctx context.Context, command AddStoragePlaceCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("add storage place command")
+	}
+
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	courierAggregate, err := uow.CourierRepository().Get(ctx, command.CourierID())
+	if err != nil {
+		return err
+	}
+
+	err = courierAggregate.AddStoragePlace(command.Name(), command.TotalVolume(), command.MaxWeight())
+	if err != nil {
+		return err
+	}
+
+	err = uow.CourierRepository().Update(ctx, courierAggregate)
+	if err != nil {
+		return err
+	}
+
+	return uow.Commit(ctx)
+}
@@ -2,10 +2,13 @@ package commands
 
 import (
 	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
 	"errors"
+	"time"
 )
 
 type AssignOrdersCommandHandler interface {
@@ -14,6 +17,10 @@ type AssignOrdersCommandHandler interface {
 
 var _ AssignOrdersCommandHandler = &assignOrderCommandHandler{}
 
+// assignCandidatesLimit - сколько самых срочных заказов перебирается за запуск,
+// если более срочные сейчас никто не может взять
+const assignCandidatesLimit = 20
+
 type assignOrderCommandHandler struct {
 	uowFactory      ports.UnitOfWorkFactory
 	orderDispatcher services.OrderDispatcher
@@ -45,15 +52,18 @@ func (h assignOrderCommandHandler) Handle(ctx context.Context, command AssignOrd
 	}
 	defer uow.RollbackUnlessCommitted(ctx)
 
-	orderAggregate, err := uow.OrderRepository().GetFirstInCreatedStatus(ctx)
+	uow.Begin(ctx)
+
+	orders, err := uow.OrderRepository().GetMostUrgentInCreatedStatus(ctx, assignCandidatesLimit)
 	if err != nil {
-		if errors.Is(err, errs.ErrObjectNotFound) {
-			return nil
-		}
 		return err
 	}
 
-	couriers, err := uow.CourierRepository().GetAllFree(ctx)
+	if len(orders) == 0 {
+		return nil
+	}
+
+	couriers, err := uow.CourierRepository().GetAllAvailable(ctx)
 	if err != nil {
 		return err
 	}
@@ -62,27 +72,48 @@ func (h assignOrderCommandHandler) Handle(ctx context.Context, command AssignOrd
 		return nil
 	}
 
-	courier, err := h.orderDispatcher.Dispatch(orderAggregate, couriers)
-	if err != nil {
-		return err
-	}
+	// заказ, который сейчас никто не может взять, не должен задерживать следующие по срочности
+	now := time.Now()
+	for _, orderAggregate := range orders {
+		courier, err := h.orderDispatcher.Dispatch(orderAggregate, couriers)
+		if err == nil {
+			return h.save(ctx, uow, courier, orderAggregate)
+		}
 
-	uow.Begin(ctx)
+		if !errors.Is(err, services.ErrNoSuitableCourier) {
+			return err
+		}
 
-	err = uow.CourierRepository().Update(ctx, courier)
-	if err != nil {
-		return err
+		atRisk, err := services.CheckUndispatchedRisk(orderAggregate, couriers, now)
+		if err != nil {
+			return err
+		}
+
+		if atRisk {
+			err = uow.OrderRepository().Update(ctx, orderAggregate)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
-	err = uow.OrderRepository().Update(ctx, orderAggregate)
+	return uow.Commit(ctx)
+}
+
+func (h assignOrderCommandHandler) save(
+	ctx context.Context,
+	uow ports.UnitOfWork,
+	courierAggregate *courier.Courier,
+	orderAggregate *order.Order) error {
+	err := uow.CourierRepository().Update(ctx, courierAggregate)
 	if err != nil {
 		return err
 	}
 
-	err = uow.Commit(ctx)
+	err = uow.OrderRepository().Update(ctx, orderAggregate)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return uow.Commit(ctx)
 }
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"time"
+)
+
+var _ AssignOrdersCommandHandler = &assignOrdersBatchCommandHandler{}
+
+// assignOrdersBatchCommandHandler распределяет все созданные заказы за один запуск
+// и сохраняет назначения в одной транзакции
+type assignOrdersBatchCommandHandler struct {
+	uowFactory           ports.UnitOfWorkFactory
+	batchOrderDispatcher services.BatchOrderDispatcher
+}
+
+func NewAssignOrdersBatchCommandHandler(
+	uowFactory ports.UnitOfWorkFactory,
+	batchOrderDispatcher services.BatchOrderDispatcher) (AssignOrdersCommandHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsRequiredError("uowFactory")
+	}
+
+	if batchOrderDispatcher == nil {
+		return nil, errs.NewValueIsRequiredError("batchOrderDispatcher")
+	}
+
+	return assignOrdersBatchCommandHandler{
+		uowFactory:           uowFactory,
+		batchOrderDispatcher: batchOrderDispatcher,
+	}, nil
+}
+
+func (h assignOrdersBatchCommandHandler) Handle(ctx context.Context, command AssignOrderCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("assign order command")
+	}
+
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	orders, err := uow.OrderRepository().GetAllInCreatedStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(orders) == 0 {
+		return nil
+	}
+
+	couriers, err := uow.CourierRepository().GetAllAvailable(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(couriers) == 0 {
+		return nil
+	}
+
+	assignments, err := h.batchOrderDispatcher.Dispatch(orders, couriers)
+	if err != nil {
+		return err
+	}
+
+	for _, assignment := range assignments {
+		err = uow.OrderRepository().Update(ctx, assignment.Order)
+		if err != nil {
+			return err
+		}
+
+		err = uow.CourierRepository().Update(ctx, assignment.Courier)
+		if err != nil {
+			return err
+		}
+	}
+
+	// назначенные заказы уже не в статусе created, проверка риска их пропустит
+	now := time.Now()
+	for _, o := range orders {
+		atRisk, err := services.CheckUndispatchedRisk(o, couriers, now)
+		if err != nil {
+			return err
+		}
+
+		if !atRisk {
+			continue
+		}
+
+		err = uow.OrderRepository().Update(ctx, o)
+		if err != nil {
+			return err
+		}
+	}
+
+	return uow.Commit(ctx)
+}
@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type CancelOrderCommand struct {
+	orderID uuid.UUID
+
+	isValid bool
+}
+
+func (c CancelOrderCommand) OrderID() uuid.UUID {
+	return c.orderID
+}
+
+func (c CancelOrderCommand) IsValid() bool {
+	return c.isValid
+}
+
+func NewCancelOrderCommand(orderID uuid.UUID) (CancelOrderCommand, error) {
+	if orderID == uuid.Nil {
+		return CancelOrderCommand{}, errs.NewValueIsInvalidError("orderID")
+	}
+
+	return CancelOrderCommand{
+		orderID: orderID,
+		isValid: true,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type CancelOrderCommandHandler interface {
+	Handle(ctx context.Context, command CancelOrderCommand) error
+}
+
+var _ CancelOrderCommandHandler = &cancelOrderCommandHandler{}
+
+type cancelOrderCommandHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+func NewCancelOrderCommandHandler(uowFactory ports.UnitOfWorkFactory) (CancelOrderCommandHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsRequiredError("uowFactory")
+	}
+
+	return cancelOrderCommandHandler{
+		uowFactory: uowFactory,
+	}, nil
+}
+
+func (h cancelOrderCommandHandler) Handle(ctx context.Context, command CancelOrderCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("cancel order command")
+	}
+
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	orderAggregate, err := uow.OrderRepository().Get(ctx, command.OrderID())
+	if err != nil {
+		return err
+	}
+
+	err = orderAggregate.Cancel()
+	if err != nil {
+		return err
+	}
+
+	// Назначенный курьер больше не везет заказ, его место хранения освобождается
+	if orderAggregate.CourierID() != nil {
+		courierAggregate, err := uow.CourierRepository().Get(ctx, *orderAggregate.CourierID())
+		if err != nil {
+			return err
+		}
+
+		err = courierAggregate.ReleaseOrder(orderAggregate)
+		if err != nil {
+			return err
+		}
+
+		err = uow.CourierRepository().Update(ctx, courierAggregate)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = uow.OrderRepository().Update(ctx, orderAggregate)
+	if err != nil {
+		return err
+	}
+
+	return uow.Commit(ctx)
+}
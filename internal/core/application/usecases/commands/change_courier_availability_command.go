@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// CourierAvailabilityAction - действие, меняющее доступность курьера
+type CourierAvailabilityAction string
+
+const (
+	CourierAvailabilityActionStartShift CourierAvailabilityAction = "start_shift"
+	CourierAvailabilityActionEndShift   CourierAvailabilityAction = "end_shift"
+	CourierAvailabilityActionStartBreak CourierAvailabilityAction = "start_break"
+	CourierAvailabilityActionEndBreak   CourierAvailabilityAction = "end_break"
+)
+
+type ChangeCourierAvailabilityCommand struct {
+	courierID uuid.UUID
+	action    CourierAvailabilityAction
+
+	isValid bool
+}
+
+func (c ChangeCourierAvailabilityCommand) CourierID() uuid.UUID {
+	return c.courierID
+}
+
+func (c ChangeCourierAvailabilityCommand) Action() CourierAvailabilityAction {
+	return c.action
+}
+
+func (c ChangeCourierAvailabilityCommand) IsValid() bool {
+	return c.isValid
+}
+
+func NewChangeCourierAvailabilityCommand(
+	courierID uuid.UUID,
+	action CourierAvailabilityAction) (ChangeCourierAvailabilityCommand, error) {
+	if courierID == uuid.Nil {
+		return ChangeCourierAvailabilityCommand{}, errs.NewValueIsInvalidError("courierID")
+	}
+
+	switch action {
+	case CourierAvailabilityActionStartShift,
+		CourierAvailabilityActionEndShift,
+		CourierAvailabilityActionStartBreak,
+		CourierAvailabilityActionEndBreak:
+	default:
+		return ChangeCourierAvailabilityCommand{}, errs.NewValueIsInvalidError("action")
+	}
+
+	return ChangeCourierAvailabilityCommand{
+		courierID: courierID,
+		action:    action,
+		isValid:   true,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+)
+
+type ChangeCourierAvailabilityCommandHandler interface {
+	Handle(ctx context.Context, command ChangeCourierAvailabilityCommand) error
+}
+
+var _ ChangeCourierAvailabilityCommandHandler = &changeCourierAvailabilityCommandHandler{}
+
+type changeCourierAvailabilityCommandHandler struct {
+	uowFactory ports.UnitOfWorkFactory
+}
+
+func NewChangeCourierAvailabilityCommandHandler(
+	uowFactory ports.UnitOfWorkFactory) (ChangeCourierAvailabilityCommandHandler, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsRequiredError("uowFactory")
+	}
+
+	return changeCourierAvailabilityCommandHandler{
+		uowFactory: uowFactory,
+	}, nil
+}
+
+func (h changeCourierAvailabilityCommandHandler) Handle(ctx context.Context, command ChangeCourierAvailabilityCommand) error {
+	if !command.IsValid() {
+		return errs.NewValueIsInvalidError("change courier availability command")
+	}
+
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	courierAggregate, err := uow.CourierRepository().Get(ctx, command.CourierID())
+	if err != nil {
+		return err
+	}
+
+	err = h.apply(courierAggregate, command.Action())
+	if err != nil {
+		return err
+	}
+
+	err = uow.CourierRepository().Update(ctx, courierAggregate)
+	if err != nil {
+		return err
+	}
+
+	return uow.Commit(ctx)
+}
+
+func (h changeCourierAvailabilityCommandHandler) apply(c *courier.Courier, action CourierAvailabilityAction) error {
+	switch action {
+	case CourierAvailabilityActionStartShift:
+		return c.StartShift()
+	case CourierAvailabilityActionEndShift:
+		return c.EndShift()
+	case CourierAvailabilityActionStartBreak:
+		return c.StartBreak()
+	case CourierAvailabilityActionEndBreak:
+		return c.EndBreak()
+	default:
+		return errs.NewValueIsInvalidError("action")
+	}
+}
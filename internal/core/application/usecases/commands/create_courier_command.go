@@ -1,9 +1,13 @@
 package commands
 
-import "delivery/internal/pkg/errs"
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+)
 
 type CreateCourierCommand struct {
 	name    string
+	vehicle courier.VehicleType
 	speed   int
 	isValid bool
 }
@@ -12,6 +16,10 @@ func (c CreateCourierCommand) IsValid() bool {
 	return c.isValid
 }
 
+func (c CreateCourierCommand) Vehicle() courier.VehicleType {
+	return c.vehicle
+}
+
 func (c CreateCourierCommand) Speed() int {
 	return c.speed
 }
@@ -20,17 +28,28 @@ func (c CreateCourierCommand) Name() string {
 	return c.name
 }
 
-func NewCreateCourierCommand(name string, speed int) (CreateCourierCommand, error) {
+// NewCreateCourierCommand создает команду добавления курьера. Если speed равна 0,
+// курьер получает скорость своего транспорта
+func NewCreateCourierCommand(name string, vehicle courier.VehicleType, speed int) (CreateCourierCommand, error) {
 	if name == "" {
 		return CreateCourierCommand{}, errs.NewValueIsInvalidError("name")
 	}
 
-	if speed <= 0 {
+	if !vehicle.IsValid() {
+		return CreateCourierCommand{}, errs.NewValueIsInvalidError("vehicle")
+	}
+
+	if speed < 0 {
 		return CreateCourierCommand{}, errs.NewValueIsInvalidError("speed")
 	}
 
+	if speed == 0 {
+		speed = vehicle.DefaultSpeed()
+	}
+
 	return CreateCourierCommand{
 		name:    name,
+		vehicle: vehicle,
 		speed:   speed,
 		isValid: true,
 	}, nil
@@ -16,15 +16,25 @@ var _ CreateCourierCommandHandler = &createCourierCommandHandler{}
 
 type createCourierCommandHandler struct {
 	uowFactory ports.UnitOfWorkFactory
+	allocation courier.AllocationStrategy
 }
 
-func NewCreateCourierCommandHandler(uowFactory ports.UnitOfWorkFactory) (CreateCourierCommandHandler, error) {
+// NewCreateCourierCommandHandler создает обработчик, назначающий новым курьерам
+// стратегию выбора места хранения allocation
+func NewCreateCourierCommandHandler(
+	uowFactory ports.UnitOfWorkFactory,
+	allocation courier.AllocationStrategy) (CreateCourierCommandHandler, error) {
 	if uowFactory == nil {
 		return nil, errs.NewValueIsRequiredError("uowFactory")
 	}
 
+	if _, err := courier.NewStoragePlaceAllocator(allocation); err != nil {
+		return nil, err
+	}
+
 	return createCourierCommandHandler{
 		uowFactory: uowFactory,
+		allocation: allocation,
 	}, nil
 }
 
@@ -41,7 +51,12 @@ func (h createCourierCommandHandler) Handle(ctx context.Context, command CreateC
 
 	l := kernel.RandomLocation()
 
-	courierAggregate, err := courier.NewCourier(command.Name(), command.Speed(), l)
+	courierAggregate, err := courier.NewCourierWithVehicle(command.Name(), command.Vehicle(), command.Speed(), l)
+	if err != nil {
+		return err
+	}
+
+	err = courierAggregate.SetAllocationStrategy(h.allocation)
 	if err != nil {
 		return err
 	}
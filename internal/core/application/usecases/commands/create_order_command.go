@@ -1,15 +1,45 @@
 package commands
 
 import (
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// OrderDetails - вес в граммах, габариты в сантиметрах и состав заказа.
+// Нулевые значения означают, что данных нет
+type OrderDetails struct {
+	Weight int
+	Length int
+	Width  int
+	Height int
+	Items  []OrderItem
+}
+
+// OrderTerms - срочность и обещанный интервал доставки. Нулевой приоритет означает обычный,
+// нулевые границы интервала - интервал не обещан
+type OrderTerms struct {
+	Priority     order.Priority
+	DeliveryFrom time.Time
+	DeliveryTo   time.Time
+}
+
+// OrderItem - позиция заказа из корзины
+type OrderItem struct {
+	GoodID   uuid.UUID
+	Title    string
+	Quantity int
+}
+
 type CreateOrderCommand struct {
 	orderID uuid.UUID
 	street  string
 	volume  int
+	details OrderDetails
+	terms   OrderTerms
 
 	isValid bool
 }
@@ -26,11 +56,26 @@ func (c CreateOrderCommand) Volume() int {
 	return c.volume
 }
 
+func (c CreateOrderCommand) Details() OrderDetails {
+	details := c.details
+	details.Items = slices.Clone(c.details.Items)
+	return details
+}
+
+func (c CreateOrderCommand) Terms() OrderTerms {
+	return c.terms
+}
+
 func (c CreateOrderCommand) IsValid() bool {
 	return c.isValid
 }
 
-func NewCreateOrderCommand(orderID uuid.UUID, street string, volume int) (CreateOrderCommand, error) {
+func NewCreateOrderCommand(
+	orderID uuid.UUID,
+	street string,
+	volume int,
+	details OrderDetails,
+	terms OrderTerms) (CreateOrderCommand, error) {
 	if orderID == uuid.Nil {
 		return CreateOrderCommand{}, errs.NewValueIsInvalidError("orderID")
 	}
@@ -43,10 +88,43 @@ func NewCreateOrderCommand(orderID uuid.UUID, street string, volume int) (Create
 		return CreateOrderCommand{}, errs.NewValueIsInvalidError("volume")
 	}
 
+	if details.Weight < 0 {
+		return CreateOrderCommand{}, errs.NewValueIsInvalidError("weight")
+	}
+
+	// габариты задаются целиком или не задаются вовсе
+	hasDimensions := details.Length != 0 || details.Width != 0 || details.Height != 0
+	if hasDimensions && (details.Length <= 0 || details.Width <= 0 || details.Height <= 0) {
+		return CreateOrderCommand{}, errs.NewValueIsInvalidError("dimensions")
+	}
+
+	for _, item := range details.Items {
+		if item.GoodID == uuid.Nil || item.Title == "" || item.Quantity <= 0 {
+			return CreateOrderCommand{}, errs.NewValueIsInvalidError("items")
+		}
+	}
+
+	details.Items = slices.Clone(details.Items)
+
+	if terms.Priority == 0 {
+		terms.Priority = order.PriorityNormal
+	}
+
+	if !terms.Priority.IsValid() {
+		return CreateOrderCommand{}, errs.NewValueIsInvalidError("priority")
+	}
+
+	hasWindow := !terms.DeliveryFrom.IsZero() || !terms.DeliveryTo.IsZero()
+	if hasWindow && (terms.DeliveryFrom.IsZero() || !terms.DeliveryTo.After(terms.DeliveryFrom)) {
+		return CreateOrderCommand{}, errs.NewValueIsInvalidError("deliveryWindow")
+	}
+
 	return CreateOrderCommand{
 		orderID: orderID,
 		street:  street,
 		volume:  volume,
+		details: details,
+		terms:   terms,
 		isValid: true,
 	}, nil
 }
@@ -2,24 +2,33 @@ package commands
 
 import (
 	"context"
+	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
 	"errors"
 )
 
+// ErrOrderAlreadyExists возвращается на повторный запрос, данные которого отличаются от уже созданного заказа
+var ErrOrderAlreadyExists = errors.New("order already exists with different data")
+
 type CreateOrderCommandHandler interface {
-	Handle(ctx context.Context, command CreateOrderCommand) error
+	Handle(ctx context.Context, command CreateOrderCommand) (CreateOrderResponse, error)
 }
 
 var _ CreateOrderCommandHandler = &createOrderCommandHandler{}
 
 type createOrderCommandHandler struct {
-	uowFactory ports.UnitOfWorkFactory
-	geoClient  ports.GeoClient
+	uowFactory        ports.UnitOfWorkFactory
+	geoClient         ports.GeoClient
+	warehouseLocation kernel.Location
 }
 
-func NewCreateOrderCommandHandler(uowFactory ports.UnitOfWorkFactory, geoClient ports.GeoClient) (CreateOrderCommandHandler, error) {
+// NewCreateOrderCommandHandler создает обработчик, который оформляет заказы с забором со склада warehouseLocation
+func NewCreateOrderCommandHandler(
+	uowFactory ports.UnitOfWorkFactory,
+	geoClient ports.GeoClient,
+	warehouseLocation kernel.Location) (CreateOrderCommandHandler, error) {
 	if uowFactory == nil {
 		return nil, errs.NewValueIsRequiredError("uowFactory")
 	}
@@ -28,48 +37,145 @@ func NewCreateOrderCommandHandler(uowFactory ports.UnitOfWorkFactory, geoClient
 		return nil, errs.NewValueIsRequiredError("geoClient")
 	}
 
+	if !warehouseLocation.IsValid() {
+		return nil, errs.NewValueIsInvalidError("warehouseLocation")
+	}
+
 	return createOrderCommandHandler{
-		uowFactory: uowFactory,
-		geoClient:  geoClient,
+		uowFactory:        uowFactory,
+		geoClient:         geoClient,
+		warehouseLocation: warehouseLocation,
 	}, nil
 }
 
-func (h createOrderCommandHandler) Handle(ctx context.Context, command CreateOrderCommand) error {
+func (h createOrderCommandHandler) Handle(ctx context.Context, command CreateOrderCommand) (CreateOrderResponse, error) {
 	if !command.IsValid() {
-		return errs.NewValueIsInvalidError("create order command")
+		return CreateOrderResponse{}, errs.NewValueIsInvalidError("create order command")
+	}
+
+	orderAggregate, err := h.newOrder(ctx, command)
+	if err != nil {
+		return CreateOrderResponse{}, err
 	}
 
 	uow, err := h.uowFactory.New(ctx)
 	if err != nil {
-		return err
+		return CreateOrderResponse{}, err
 	}
 	defer uow.RollbackUnlessCommitted(ctx)
 
-	orderAggregate, err := uow.OrderRepository().Get(ctx, command.OrderID())
-	if err != nil {
-		if !errors.Is(err, errs.ErrObjectNotFound) {
-			return err
-		}
+	existing, err := uow.OrderRepository().Get(ctx, command.OrderID())
+	if err != nil && !errors.Is(err, errs.ErrObjectNotFound) {
+		return CreateOrderResponse{}, err
 	}
 
-	if orderAggregate != nil {
-		return nil
+	if existing != nil {
+		return replay(existing, orderAggregate)
+	}
+
+	err = uow.OrderRepository().Add(ctx, orderAggregate)
+	if errors.Is(err, errs.ErrObjectAlreadyExists) {
+		// Параллельный запрос с тем же идентификатором успел создать заказ раньше
+		existing, err = uow.OrderRepository().Get(ctx, command.OrderID())
+		if err != nil {
+			return CreateOrderResponse{}, err
+		}
+
+		return replay(existing, orderAggregate)
+	}
+	if err != nil {
+		return CreateOrderResponse{}, err
 	}
 
+	return CreateOrderResponse{
+		OrderID:  orderAggregate.ID(),
+		Location: orderAggregate.Location(),
+		Created:  true,
+	}, nil
+}
+
+func (h createOrderCommandHandler) newOrder(ctx context.Context, command CreateOrderCommand) (*order.Order, error) {
 	l, err := h.geoClient.GetLocation(ctx, command.Street())
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	details, err := h.toOrderDetails(command.Details())
+	if err != nil {
+		return nil, err
 	}
 
-	orderAggregate, err = order.NewOrder(command.OrderID(), l, command.Volume())
+	orderAggregate, err := order.NewOrderWithDetails(command.OrderID(), h.warehouseLocation, l, command.Volume(), details)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.applyTerms(orderAggregate, command.Terms())
+	if err != nil {
+		return nil, err
+	}
+
+	return orderAggregate, nil
+}
+
+func (h createOrderCommandHandler) toOrderDetails(details OrderDetails) (order.Details, error) {
+	var dimensions order.Dimensions
+	if details.Length != 0 || details.Width != 0 || details.Height != 0 {
+		var err error
+		dimensions, err = order.NewDimensions(details.Length, details.Width, details.Height)
+		if err != nil {
+			return order.Details{}, err
+		}
+	}
+
+	items := make([]order.Item, 0, len(details.Items))
+	for _, i := range details.Items {
+		item, err := order.NewItem(i.GoodID, i.Title, i.Quantity)
+		if err != nil {
+			return order.Details{}, err
+		}
+		items = append(items, item)
+	}
+
+	return order.NewDetails(details.Weight, dimensions, items)
+}
+
+func (h createOrderCommandHandler) applyTerms(o *order.Order, terms OrderTerms) error {
+	err := o.Prioritize(terms.Priority)
 	if err != nil {
 		return err
 	}
 
-	err = uow.OrderRepository().Add(ctx, orderAggregate)
+	if terms.DeliveryFrom.IsZero() && terms.DeliveryTo.IsZero() {
+		return nil
+	}
+
+	window, err := order.NewDeliveryWindow(terms.DeliveryFrom, terms.DeliveryTo)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return o.PromiseDeliveryWindow(window)
+}
+
+// replay возвращает уже созданный заказ на повторный запрос с тем же идентификатором.
+// Повтор с другими данными отклоняется, чтобы не выдать клиенту чужой заказ за его собственный
+func replay(existing *order.Order, requested *order.Order) (CreateOrderResponse, error) {
+	if !sameOrder(existing, requested) {
+		return CreateOrderResponse{}, ErrOrderAlreadyExists
+	}
+
+	return CreateOrderResponse{
+		OrderID:  existing.ID(),
+		Location: existing.Location(),
+		Created:  false,
+	}, nil
+}
+
+func sameOrder(existing *order.Order, requested *order.Order) bool {
+	return existing.Location().Equals(requested.Location()) &&
+		existing.Volume() == requested.Volume() &&
+		existing.Details().Equals(requested.Details()) &&
+		existing.Priority() == requested.Priority() &&
+		existing.DeliveryWindow().Equals(requested.DeliveryWindow())
 }
@@ -0,0 +1,14 @@
+package commands
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/google/uuid"
+)
+
+type CreateOrderResponse struct {
+	OrderID  uuid.UUID
+	Location kernel.Location
+	// Created равен false, если заказ с таким идентификатором уже существовал
+	Created bool
+}
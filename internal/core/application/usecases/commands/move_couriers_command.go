@@ -1,6 +1,13 @@
 package commands
 
+import (
+	"delivery/internal/pkg/errs"
+	"time"
+)
+
 type MoveCouriersCommand struct {
+	elapsed time.Duration
+
 	isValid bool
 }
 
@@ -8,6 +15,15 @@ func (c MoveCouriersCommand) IsValid() bool {
 	return c.isValid
 }
 
-func NewMoveCouriersCommand() (MoveCouriersCommand, error) {
-	return MoveCouriersCommand{isValid: true}, nil
+// Elapsed возвращает время, прошедшее с предыдущего перемещения курьеров
+func (c MoveCouriersCommand) Elapsed() time.Duration {
+	return c.elapsed
+}
+
+func NewMoveCouriersCommand(elapsed time.Duration) (MoveCouriersCommand, error) {
+	if elapsed < 0 {
+		return MoveCouriersCommand{}, errs.NewValueIsInvalidError("elapsed")
+	}
+
+	return MoveCouriersCommand{elapsed: elapsed, isValid: true}, nil
 }
@@ -2,8 +2,15 @@ package commands
 
 import (
 	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type MoveCouriersCommandHandler interface {
@@ -37,51 +44,130 @@ func (h moveCouriersCommandHandler) Handle(ctx context.Context, command MoveCour
 	}
 	defer uow.RollbackUnlessCommitted(ctx)
 
-	assignedOrders, err := uow.OrderRepository().GetAllInAssignedStatus(ctx)
+	ordersInDelivery, err := uow.OrderRepository().GetAllInDelivery(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, order := range assignedOrders {
-		uow.Begin(ctx)
+	// Курьер может везти несколько заказов, поэтому за один шаг он
+	// перемещается один раз - к ближайшей точке своего маршрута
+	courierIDs := make([]uuid.UUID, 0)
+	ordersByCourier := make(map[uuid.UUID][]*order.Order)
+	for _, o := range ordersInDelivery {
+		courierID := *o.CourierID()
+		if _, ok := ordersByCourier[courierID]; !ok {
+			courierIDs = append(courierIDs, courierID)
+		}
+		ordersByCourier[courierID] = append(ordersByCourier[courierID], o)
+	}
 
-		courier, err := uow.CourierRepository().Get(ctx, *order.CourierID())
+	// Каждый курьер перемещается в своей транзакции, поэтому ошибка одного курьера
+	// не останавливает остальных и возвращается вместе с прочими после шага
+	var moveErrs []error
+	for _, courierID := range courierIDs {
+		err = h.moveCourier(ctx, uow, courierID, ordersByCourier[courierID], command.Elapsed())
 		if err != nil {
-			return err
+			uow.RollbackUnlessCommitted(ctx)
+			moveErrs = append(moveErrs, fmt.Errorf("move courier %s: %w", courierID, err))
 		}
+	}
+
+	return errors.Join(moveErrs...)
+}
+
+func (h moveCouriersCommandHandler) moveCourier(
+	ctx context.Context,
+	uow ports.UnitOfWork,
+	courierID uuid.UUID,
+	orders []*order.Order,
+	elapsed time.Duration) error {
+	uow.Begin(ctx)
+
+	courier, err := uow.CourierRepository().Get(ctx, courierID)
+	if err != nil {
+		return err
+	}
+
+	route, err := courier.PlanRoute(orders)
+	if err != nil {
+		return err
+	}
 
-		err = courier.Move(order.Location())
+	err = courier.MoveFor(route[0].Location, elapsed)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, o := range orders {
+		changed, err := h.arrive(courier, o)
 		if err != nil {
 			return err
 		}
 
-		if courier.Location().Equals(order.Location()) {
-			err = order.Complete()
-			if err != nil {
-				return err
-			}
+		atRisk, err := h.checkDeliveryRisk(courier, o, now)
+		if err != nil {
+			return err
+		}
 
-			err = courier.CompleteOrder(order)
-			if err != nil {
-				return err
-			}
+		if !changed && !atRisk {
+			continue
 		}
 
-		err = uow.OrderRepository().Update(ctx, order)
+		err = uow.OrderRepository().Update(ctx, o)
 		if err != nil {
 			return err
 		}
+	}
+
+	err = uow.CourierRepository().Update(ctx, courier)
+	if err != nil {
+		return err
+	}
 
-		err = uow.CourierRepository().Update(ctx, courier)
+	return uow.Commit(ctx)
+}
+
+// arrive забирает заказ или доставляет его, если курьер оказался в нужной точке.
+// Если место забора совпадает с местом доставки, заказ доставляется сразу
+func (h moveCouriersCommandHandler) arrive(c *courier.Courier, o *order.Order) (bool, error) {
+	changed := false
+
+	if o.Status() == order.StatusAssigned && c.Location().Equals(o.PickupLocation()) {
+		err := o.PickUp()
 		if err != nil {
-			return err
+			return false, err
+		}
+		changed = true
+	}
+
+	if o.Status() == order.StatusPickedUp && c.Location().Equals(o.Location()) {
+		err := o.Complete()
+		if err != nil {
+			return false, err
 		}
 
-		err = uow.Commit(ctx)
+		err = c.CompleteOrder(o)
 		if err != nil {
-			return err
+			return false, err
 		}
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// checkDeliveryRisk отмечает заказ, который курьер не успевает доставить к концу обещанного интервала.
+// Оценка не учитывает другие точки маршрута курьера, поэтому риск может обнаружиться позже
+func (h moveCouriersCommandHandler) checkDeliveryRisk(c *courier.Courier, o *order.Order, now time.Time) (bool, error) {
+	if !o.DeliveryWindow().IsValid() || o.Status() == order.StatusCompleted {
+		return false, nil
+	}
+
+	eta, err := c.EstimateTimeToDeliver(o)
+	if err != nil {
+		return false, err
 	}
 
-	return nil
+	return o.CheckDeliveryRisk(now.Add(eta)), nil
 }
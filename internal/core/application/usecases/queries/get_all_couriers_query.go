@@ -1,11 +1,72 @@
 package queries
 
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// CouriersFilter - условия отбора курьеров. Busy: true - только курьеры с заказами,
+// false - только свободные, nil - все. Пустой Availabilities не ограничивает доступность
+type CouriersFilter struct {
+	Busy           *bool
+	Availabilities []courier.Availability
+	Area           Area
+}
+
 type GetAllCouriersQuery struct {
+	filter CouriersFilter
+	page   page[CourierResponse]
+
 	isValid bool
 }
 
-func NewGetAllCouriersQuery() (GetAllCouriersQuery, error) {
-	return GetAllCouriersQuery{isValid: true}, nil
+var courierAvailabilities = []courier.Availability{
+	courier.AvailabilityOffShift,
+	courier.AvailabilityOnShift,
+	courier.AvailabilityOnBreak,
+}
+
+var courierSortFields = map[string]sortField[CourierResponse]{
+	"id": {
+		column: "id",
+		value:  func(c CourierResponse) string { return c.ID.String() },
+		id:     func(c CourierResponse) uuid.UUID { return c.ID },
+	},
+	"name": {
+		column: "name",
+		value:  func(c CourierResponse) string { return c.Name },
+		id:     func(c CourierResponse) uuid.UUID { return c.ID },
+	},
+}
+
+func NewGetAllCouriersQuery(filter CouriersFilter, request PageRequest) (GetAllCouriersQuery, error) {
+	for _, availability := range filter.Availabilities {
+		if !slices.Contains(courierAvailabilities, availability) {
+			return GetAllCouriersQuery{}, errs.NewValueIsInvalidError("availability")
+		}
+	}
+
+	if err := filter.Area.validate(); err != nil {
+		return GetAllCouriersQuery{}, err
+	}
+
+	p, err := newPage(request, courierSortFields, "id")
+	if err != nil {
+		return GetAllCouriersQuery{}, err
+	}
+
+	return GetAllCouriersQuery{
+		filter:  filter,
+		page:    p,
+		isValid: true,
+	}, nil
+}
+
+func (q GetAllCouriersQuery) Filter() CouriersFilter {
+	return q.filter
 }
 
 func (q GetAllCouriersQuery) IsValid() bool {
@@ -27,12 +27,33 @@ func (q *getAllCouriersQueryHandler) Handle(query GetAllCouriersQuery) (GetAllCo
 		return GetAllCouriersResponse{}, errs.NewValueIsInvalidError("query")
 	}
 
-	var couriers []CourierResponse
-	result := q.db.Raw("SELECT id, name, location_x, location_y FROM couriers").Scan(&couriers)
+	db := q.db.Table("couriers").
+		Select("id, name, location_x, location_y, vehicle_type, availability")
+
+	filter := query.Filter()
+	if filter.Busy != nil {
+		busy := "EXISTS (SELECT 1 FROM storage_places sp WHERE sp.courier_id = couriers.id AND sp.order_id IS NOT NULL)"
+		if !*filter.Busy {
+			busy = "NOT " + busy
+		}
+		db = db.Where(busy)
+	}
+
+	if len(filter.Availabilities) > 0 {
+		db = db.Where("availability IN ?", filter.Availabilities)
+	}
+	db = filter.Area.apply(db)
 
+	var couriers []CourierResponse
+	result := query.page.apply(db).Scan(&couriers)
 	if result.Error != nil {
 		return GetAllCouriersResponse{}, result.Error
 	}
 
-	return GetAllCouriersResponse{Couriers: couriers}, nil
+	couriers, nextCursor, err := query.page.cut(couriers)
+	if err != nil {
+		return GetAllCouriersResponse{}, err
+	}
+
+	return GetAllCouriersResponse{Couriers: couriers, NextCursor: nextCursor}, nil
 }
@@ -6,12 +6,16 @@ import (
 
 type GetAllCouriersResponse struct {
 	Couriers []CourierResponse
+	// NextCursor - курсор следующей страницы, пустой на последней странице
+	NextCursor string
 }
 
 type CourierResponse struct {
-	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
-	Name     string
-	Location LocationResponse `gorm:"embedded;embeddedPrefix:location_"`
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name         string
+	Location     LocationResponse `gorm:"embedded;embeddedPrefix:location_"`
+	VehicleType  string
+	Availability string
 }
 
 func (CourierResponse) TableName() string {
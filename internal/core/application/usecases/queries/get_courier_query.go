@@ -0,0 +1,32 @@
+package queries
+
+import (
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type GetCourierQuery struct {
+	courierID uuid.UUID
+
+	isValid bool
+}
+
+func NewGetCourierQuery(courierID uuid.UUID) (GetCourierQuery, error) {
+	if courierID == uuid.Nil {
+		return GetCourierQuery{}, errs.NewValueIsRequiredError("courierID")
+	}
+
+	return GetCourierQuery{
+		courierID: courierID,
+		isValid:   true,
+	}, nil
+}
+
+func (q GetCourierQuery) CourierID() uuid.UUID {
+	return q.courierID
+}
+
+func (q GetCourierQuery) IsValid() bool {
+	return q.isValid
+}
@@ -0,0 +1,135 @@
+package queries
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type GetCourierQueryHandler interface {
+	Handle(context.Context, GetCourierQuery) (GetCourierResponse, error)
+}
+
+type getCourierQueryHandler struct {
+	db *gorm.DB
+}
+
+func NewGetCourierQueryHandler(db *gorm.DB) (GetCourierQueryHandler, error) {
+	if db == nil {
+		return &getCourierQueryHandler{}, errs.NewValueIsInvalidError("db")
+	}
+
+	return &getCourierQueryHandler{db: db}, nil
+}
+
+// storagePlaceRow - место хранения вместе с заказом, который в нем лежит
+type storagePlaceRow struct {
+	StoragePlaceResponse
+	OrderStatus    *order.Status
+	OrderLocation  LocationResponse `gorm:"embedded;embeddedPrefix:location_"`
+	PickupLocation LocationResponse `gorm:"embedded;embeddedPrefix:pickup_location_"`
+}
+
+func (q *getCourierQueryHandler) Handle(ctx context.Context, query GetCourierQuery) (GetCourierResponse, error) {
+	if !query.IsValid() {
+		return GetCourierResponse{}, errs.NewValueIsInvalidError("query")
+	}
+
+	db := q.db.WithContext(ctx)
+
+	var response GetCourierResponse
+	result := db.Raw("SELECT id, name, vehicle_type, availability, speed, location_x, location_y FROM couriers WHERE id = ?",
+		query.CourierID()).Scan(&response)
+	if result.Error != nil {
+		return GetCourierResponse{}, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return GetCourierResponse{}, errs.NewObjectNotFoundError("Courier", query.CourierID())
+	}
+
+	var rows []storagePlaceRow
+	result = db.Raw(`
+		SELECT sp.id, sp.name, sp.total_volume, sp.max_weight, sp.order_id,
+			COALESCE(o.volume, 0) AS used_volume, o.status AS order_status,
+			COALESCE(o.location_x, 0) AS location_x, COALESCE(o.location_y, 0) AS location_y,
+			COALESCE(o.pickup_location_x, 0) AS pickup_location_x, COALESCE(o.pickup_location_y, 0) AS pickup_location_y
+		FROM storage_places sp
+		LEFT JOIN orders o ON o.id = sp.order_id
+		WHERE sp.courier_id = ?
+		ORDER BY sp.name, sp.id`,
+		query.CourierID()).Scan(&rows)
+	if result.Error != nil {
+		return GetCourierResponse{}, result.Error
+	}
+
+	for _, row := range rows {
+		response.StoragePlaces = append(response.StoragePlaces, row.StoragePlaceResponse)
+	}
+
+	target, err := q.target(response, rows)
+	if err != nil {
+		return GetCourierResponse{}, err
+	}
+	response.Target = target
+
+	return response, nil
+}
+
+// target возвращает ближайшую к курьеру точку по заказам, которые он везет: место забора назначенного
+// заказа или место доставки забранного. Так же выбирается первая точка маршрута курьера
+func (q *getCourierQueryHandler) target(c GetCourierResponse, rows []storagePlaceRow) (*CourierTargetResponse, error) {
+	metric := kernel.CurrentDistanceMetric()
+	from, err := kernel.NewLocation(c.Location.X, c.Location.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		target      *CourierTargetResponse
+		minDistance float64
+	)
+	for _, row := range rows {
+		if row.OrderID == nil || row.OrderStatus == nil {
+			continue
+		}
+
+		var stop CourierTargetResponse
+		switch *row.OrderStatus {
+		case order.StatusAssigned:
+			stop = CourierTargetResponse{OrderID: *row.OrderID, Location: row.PickupLocation, IsPickup: true}
+		case order.StatusPickedUp:
+			stop = CourierTargetResponse{OrderID: *row.OrderID, Location: row.OrderLocation}
+		default:
+			continue
+		}
+
+		to, err := kernel.NewLocation(stop.Location.X, stop.Location.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		distance := metric.Distance(from, to)
+		if target == nil || distance < minDistance {
+			minDistance = distance
+			target = &stop
+		}
+	}
+
+	if target == nil {
+		return nil, nil
+	}
+
+	eta := metric.TravelTime(c.Speed, minDistance)
+	if eta != time.Duration(math.MaxInt64) {
+		arrivalAt := time.Now().UTC().Add(eta)
+		target.EstimatedArrivalAt = &arrivalAt
+	}
+
+	return target, nil
+}
@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GetCourierResponse struct {
+	ID            uuid.UUID
+	Name          string
+	VehicleType   string
+	Availability  string
+	Speed         int
+	Location      LocationResponse       `gorm:"embedded;embeddedPrefix:location_"`
+	StoragePlaces []StoragePlaceResponse `gorm:"-"`
+	// Target - ближайшая точка маршрута курьера, nil если у курьера нет заказов
+	Target *CourierTargetResponse `gorm:"-"`
+}
+
+type StoragePlaceResponse struct {
+	ID          uuid.UUID
+	Name        string
+	TotalVolume int
+	// UsedVolume - объем заказа, который лежит в месте хранения
+	UsedVolume int
+	MaxWeight  int
+	OrderID    *uuid.UUID
+}
+
+type CourierTargetResponse struct {
+	OrderID  uuid.UUID
+	Location LocationResponse
+	IsPickup bool
+	// EstimatedArrivalAt - nil, если до точки нельзя построить маршрут
+	EstimatedArrivalAt *time.Time
+}
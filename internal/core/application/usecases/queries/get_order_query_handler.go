@@ -0,0 +1,51 @@
+package queries
+
+import (
+	"context"
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm"
+)
+
+type GetOrderQueryHandler interface {
+	Handle(context.Context, GetOrderQuery) (GetOrderResponse, error)
+}
+
+type getOrderQueryHandler struct {
+	db *gorm.DB
+}
+
+func NewGetOrderQueryHandler(db *gorm.DB) (GetOrderQueryHandler, error) {
+	if db == nil {
+		return &getOrderQueryHandler{}, errs.NewValueIsInvalidError("db")
+	}
+
+	return &getOrderQueryHandler{db: db}, nil
+}
+
+func (q *getOrderQueryHandler) Handle(ctx context.Context, query GetOrderQuery) (GetOrderResponse, error) {
+	if !query.IsValid() {
+		return GetOrderResponse{}, errs.NewValueIsInvalidError("query")
+	}
+
+	db := q.db.WithContext(ctx)
+
+	var response GetOrderResponse
+	result := db.Raw("SELECT id, status, volume, courier_id, location_x, location_y FROM orders WHERE id = ?",
+		query.OrderID()).Scan(&response)
+	if result.Error != nil {
+		return GetOrderResponse{}, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return GetOrderResponse{}, errs.NewObjectNotFoundError("Order", query.OrderID())
+	}
+
+	result = db.Raw("SELECT status, changed_at FROM order_status_history WHERE order_id = ? ORDER BY changed_at, id",
+		query.OrderID()).Scan(&response.History)
+	if result.Error != nil {
+		return GetOrderResponse{}, result.Error
+	}
+
+	return response, nil
+}
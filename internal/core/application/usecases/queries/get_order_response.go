@@ -0,0 +1,22 @@
+package queries
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GetOrderResponse struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Status    string
+	Volume    int
+	CourierID *uuid.UUID
+	Location  LocationResponse `gorm:"embedded;embeddedPrefix:location_"`
+	// History - смены статуса заказа в порядке их возникновения
+	History []OrderStatusChangeResponse `gorm:"-"`
+}
+
+type OrderStatusChangeResponse struct {
+	Status    string
+	ChangedAt time.Time
+}
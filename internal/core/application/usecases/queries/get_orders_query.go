@@ -0,0 +1,89 @@
+package queries
+
+import (
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// OrdersFilter - условия отбора заказов. NotCompleted оставляет только заказы,
+// которые еще не доставлены и не отменены
+type OrdersFilter struct {
+	NotCompleted bool
+	Statuses     []order.Status
+	CourierID    *uuid.UUID
+	Area         Area
+}
+
+type GetOrdersQuery struct {
+	filter OrdersFilter
+	page   page[OrderResponse]
+
+	isValid bool
+}
+
+var orderStatuses = []order.Status{
+	order.StatusCreated,
+	order.StatusAssigned,
+	order.StatusPickedUp,
+	order.StatusCompleted,
+	order.StatusCanceled,
+}
+
+var orderSortFields = map[string]sortField[OrderResponse]{
+	"id": {
+		column: "id",
+		value:  func(o OrderResponse) string { return o.ID.String() },
+		id:     func(o OrderResponse) uuid.UUID { return o.ID },
+	},
+	"volume": {
+		column:  "volume",
+		numeric: true,
+		value:   func(o OrderResponse) string { return strconv.Itoa(o.Volume) },
+		id:      func(o OrderResponse) uuid.UUID { return o.ID },
+	},
+	"priority": {
+		column:  "priority",
+		numeric: true,
+		value:   func(o OrderResponse) string { return strconv.Itoa(o.Priority) },
+		id:      func(o OrderResponse) uuid.UUID { return o.ID },
+	},
+}
+
+func NewGetOrdersQuery(filter OrdersFilter, request PageRequest) (GetOrdersQuery, error) {
+	for _, status := range filter.Statuses {
+		if !slices.Contains(orderStatuses, status) {
+			return GetOrdersQuery{}, errs.NewValueIsInvalidError("status")
+		}
+	}
+
+	if filter.CourierID != nil && *filter.CourierID == uuid.Nil {
+		return GetOrdersQuery{}, errs.NewValueIsInvalidError("courierID")
+	}
+
+	if err := filter.Area.validate(); err != nil {
+		return GetOrdersQuery{}, err
+	}
+
+	p, err := newPage(request, orderSortFields, "id")
+	if err != nil {
+		return GetOrdersQuery{}, err
+	}
+
+	return GetOrdersQuery{
+		filter:  filter,
+		page:    p,
+		isValid: true,
+	}, nil
+}
+
+func (q GetOrdersQuery) Filter() OrdersFilter {
+	return q.filter
+}
+
+func (q GetOrdersQuery) IsValid() bool {
+	return q.isValid
+}
@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm"
+)
+
+type GetOrdersQueryHandler interface {
+	Handle(GetOrdersQuery) (GetOrdersResponse, error)
+}
+
+type getOrdersQueryHandler struct {
+	db *gorm.DB
+}
+
+func NewGetOrdersQueryHandler(db *gorm.DB) (GetOrdersQueryHandler, error) {
+	if db == nil {
+		return &getOrdersQueryHandler{}, errs.NewValueIsInvalidError("db")
+	}
+
+	return &getOrdersQueryHandler{db: db}, nil
+}
+
+func (q *getOrdersQueryHandler) Handle(query GetOrdersQuery) (GetOrdersResponse, error) {
+	if !query.IsValid() {
+		return GetOrdersResponse{}, errs.NewValueIsInvalidError("query")
+	}
+
+	db := q.db.Table("orders").
+		Select("id, status, volume, priority, courier_id, location_x, location_y")
+
+	filter := query.Filter()
+	if filter.NotCompleted {
+		db = db.Where("status NOT IN ?", []order.Status{order.StatusCompleted, order.StatusCanceled})
+	}
+
+	if len(filter.Statuses) > 0 {
+		db = db.Where("status IN ?", filter.Statuses)
+	}
+
+	if filter.CourierID != nil {
+		db = db.Where("courier_id = ?", *filter.CourierID)
+	}
+	db = filter.Area.apply(db)
+
+	var orders []OrderResponse
+	result := query.page.apply(db).Scan(&orders)
+	if result.Error != nil {
+		return GetOrdersResponse{}, result.Error
+	}
+
+	orders, nextCursor, err := query.page.cut(orders)
+	if err != nil {
+		return GetOrdersResponse{}, err
+	}
+
+	return GetOrdersResponse{Orders: orders, NextCursor: nextCursor}, nil
+}
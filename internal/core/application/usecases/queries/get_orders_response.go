@@ -0,0 +1,24 @@
+package queries
+
+import (
+	"github.com/google/uuid"
+)
+
+type GetOrdersResponse struct {
+	Orders []OrderResponse
+	// NextCursor - курсор следующей страницы, пустой на последней странице
+	NextCursor string
+}
+
+type OrderResponse struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Status    string
+	Volume    int
+	Priority  int
+	CourierID *uuid.UUID
+	Location  LocationResponse `gorm:"embedded;embeddedPrefix:location_"`
+}
+
+func (OrderResponse) TableName() string {
+	return "orders"
+}
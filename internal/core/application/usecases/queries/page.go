@@ -0,0 +1,217 @@
+package queries
+
+import (
+	"delivery/internal/pkg/errs"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
+)
+
+// PageRequest - параметры постраничной выборки списка.
+// Cursor - значение NextCursor из предыдущей страницы, пустой курсор означает первую страницу.
+// Sort - поле сортировки, префикс "-" задает сортировку по убыванию. Limit 0 - размер по умолчанию
+type PageRequest struct {
+	Cursor string
+	Limit  int
+	Sort   string
+}
+
+// Area - прямоугольная область карты. Незаданные границы не ограничивают выборку
+type Area struct {
+	MinX *int
+	MinY *int
+	MaxX *int
+	MaxY *int
+}
+
+func (a Area) validate() error {
+	if a.MinX != nil && a.MaxX != nil && *a.MinX > *a.MaxX {
+		return errs.NewValueIsInvalidError("area")
+	}
+
+	if a.MinY != nil && a.MaxY != nil && *a.MinY > *a.MaxY {
+		return errs.NewValueIsInvalidError("area")
+	}
+
+	return nil
+}
+
+func (a Area) apply(db *gorm.DB) *gorm.DB {
+	if a.MinX != nil {
+		db = db.Where("location_x >= ?", *a.MinX)
+	}
+
+	if a.MinY != nil {
+		db = db.Where("location_y >= ?", *a.MinY)
+	}
+
+	if a.MaxX != nil {
+		db = db.Where("location_x <= ?", *a.MaxX)
+	}
+
+	if a.MaxY != nil {
+		db = db.Where("location_y <= ?", *a.MaxY)
+	}
+
+	return db
+}
+
+// sortField - поле, по которому можно сортировать список строк T.
+// Строки с одинаковым значением поля упорядочиваются по id
+type sortField[T any] struct {
+	column  string
+	numeric bool
+	value   func(T) string
+	id      func(T) uuid.UUID
+}
+
+// cursor указывает на последнюю строку страницы: значение поля сортировки и id
+type cursor struct {
+	Sort  string    `json:"s"`
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// page - проверенные параметры выборки. Выборка идет по ключу (keyset), а не по смещению,
+// поэтому стоимость страницы не зависит от ее номера
+type page[T any] struct {
+	sort       string
+	field      sortField[T]
+	descending bool
+	limit      int
+	after      *cursor
+}
+
+func newPage[T any](request PageRequest, fields map[string]sortField[T], defaultSort string) (page[T], error) {
+	limit := request.Limit
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+
+	if limit < 0 || limit > MaxPageLimit {
+		return page[T]{}, errs.NewValueIsOutOfRangeError("limit", limit, 1, MaxPageLimit)
+	}
+
+	sort := request.Sort
+	if sort == "" {
+		sort = defaultSort
+	}
+
+	name, descending := strings.CutPrefix(sort, "-")
+	field, ok := fields[name]
+	if !ok {
+		return page[T]{}, errs.NewValueIsInvalidError("sort")
+	}
+
+	p := page[T]{
+		sort:       sort,
+		field:      field,
+		descending: descending,
+		limit:      limit,
+	}
+
+	if request.Cursor == "" {
+		return p, nil
+	}
+
+	after, err := decodeCursor(request.Cursor)
+	if err != nil || after.Sort != sort || after.ID == uuid.Nil {
+		return page[T]{}, errs.NewValueIsInvalidError("cursor")
+	}
+
+	if field.numeric {
+		if _, err := strconv.Atoi(after.Value); err != nil {
+			return page[T]{}, errs.NewValueIsInvalidError("cursor")
+		}
+	}
+	p.after = &after
+
+	return p, nil
+}
+
+// apply добавляет к запросу условие продолжения, сортировку и лимит.
+// Запрашивается на одну строку больше, чтобы понять, есть ли следующая страница
+func (p page[T]) apply(db *gorm.DB) *gorm.DB {
+	operator, direction := ">", "ASC"
+	if p.descending {
+		operator, direction = "<", "DESC"
+	}
+
+	if p.after != nil {
+		if p.field.column == "id" {
+			db = db.Where("id "+operator+" ?", p.after.ID)
+		} else {
+			db = db.Where(fmt.Sprintf("(%s, id) %s (?, ?)", p.field.column, operator), p.cursorValue(), p.after.ID)
+		}
+	}
+
+	if p.field.column != "id" {
+		db = db.Order(p.field.column + " " + direction)
+	}
+
+	return db.Order("id " + direction).Limit(p.limit + 1)
+}
+
+func (p page[T]) cursorValue() any {
+	if p.field.numeric {
+		value, _ := strconv.Atoi(p.after.Value)
+		return value
+	}
+
+	return p.after.Value
+}
+
+// cut отбрасывает лишнюю строку и возвращает курсор следующей страницы,
+// пустой курсор означает, что страница последняя
+func (p page[T]) cut(rows []T) ([]T, string, error) {
+	if len(rows) <= p.limit {
+		return rows, "", nil
+	}
+
+	rows = rows[:p.limit]
+	last := rows[len(rows)-1]
+
+	next, err := encodeCursor(cursor{
+		Sort:  p.sort,
+		Value: p.field.value(last),
+		ID:    p.field.id(last),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return rows, next, nil
+}
+
+func encodeCursor(c cursor) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(value string) (cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor{}, err
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return cursor{}, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,20 @@
+package courier
+
+const (
+	// AvailabilityOffShift - курьер не работает и не получает заказы
+	AvailabilityOffShift Availability = "off_shift"
+	// AvailabilityOnShift - курьер на смене и может получать заказы
+	AvailabilityOnShift Availability = "on_shift"
+	// AvailabilityOnBreak - курьер на смене, но на перерыве и заказы не получает
+	AvailabilityOnBreak Availability = "on_break"
+)
+
+type Availability string
+
+func (a Availability) Equals(other Availability) bool {
+	return a == other
+}
+
+func (a Availability) String() string {
+	return string(a)
+}
@@ -6,29 +6,53 @@ import (
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
 	"errors"
-	"math"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrNoSuitablePlace = errors.New("no suitable place")
-	ErrOrderNotFound   = errors.New("order not found")
+	ErrNoSuitablePlace       = errors.New("no suitable place")
+	ErrOrderNotFound         = errors.New("order not found")
+	ErrCourierAlreadyOnShift = errors.New("courier already on shift")
+	ErrCourierNotOnShift     = errors.New("courier not on shift")
+	ErrCourierNotOnBreak     = errors.New("courier not on break")
+	ErrCourierHasOrders      = errors.New("courier has orders")
 )
 
+var _ ddd.AggregateRoot = &Courier{}
+
 type Courier struct {
 	baseAggregate *ddd.BaseAggregate[uuid.UUID]
 	name          string
+	vehicle       VehicleType
 	speed         int
 	location      kernel.Location
-	storagePlaces []*StoragePlace
+	// pendingDistance - пройденный путь, которого пока не хватило, чтобы перейти в следующую клетку.
+	// Отрицательный, если при округлении до клетки курьер продвинулся дальше, чем прошел
+	pendingDistance float64
+	storagePlaces   []*StoragePlace
+	allocator       StoragePlaceAllocator
+	availability    Availability
+	// shift - текущая смена. После EndShift хранит завершенную смену до сохранения курьера
+	shift *Shift
 }
 
+// NewCourier создает пешего курьера с заданной скоростью
 func NewCourier(name string, speed int, location kernel.Location) (*Courier, error) {
+	return NewCourierWithVehicle(name, VehicleFoot, speed, location)
+}
+
+// NewCourierWithVehicle создает курьера с местами хранения, которые положены его транспорту
+func NewCourierWithVehicle(name string, vehicle VehicleType, speed int, location kernel.Location) (*Courier, error) {
 	if name == "" {
 		return nil, errs.NewValueIsInvalidError("name")
 	}
 
+	if !vehicle.IsValid() {
+		return nil, errs.NewValueIsInvalidError("vehicle")
+	}
+
 	if speed <= 0 {
 		return nil, errs.NewValueIsInvalidError("speed")
 	}
@@ -40,26 +64,55 @@ func NewCourier(name string, speed int, location kernel.Location) (*Courier, err
 	c := &Courier{
 		baseAggregate: ddd.NewBaseAggregate(uuid.New()),
 		name:          name,
+		vehicle:       vehicle,
 		speed:         speed,
 		location:      location,
 		storagePlaces: make([]*StoragePlace, 0),
+		allocator:     NewBestFitAllocator(),
+		availability:  AvailabilityOffShift,
 	}
 
-	err := c.AddStoragePlace("Bag", 10)
-	if err != nil {
-		return nil, err
+	c.RaiseDomainEvent(NewCourierCreatedDomainEvent(c))
+
+	for _, place := range vehicleProfiles[vehicle].storagePlaces {
+		err := c.AddStoragePlace(place.name, place.volume, place.maxWeight)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return c, nil
 }
 
-func RestoreCourier(id uuid.UUID, name string, speed int, location kernel.Location, storagePlaces []*StoragePlace) *Courier {
+func RestoreCourier(
+	id uuid.UUID,
+	name string,
+	vehicle VehicleType,
+	speed int,
+	location kernel.Location,
+	storagePlaces []*StoragePlace,
+	availability Availability,
+	shift *Shift,
+	allocation AllocationStrategy,
+	pendingDistance float64,
+	version int64) *Courier {
+	// неизвестная стратегия из хранилища не должна мешать загрузке курьера
+	allocator, err := NewStoragePlaceAllocator(allocation)
+	if err != nil {
+		allocator = NewBestFitAllocator()
+	}
+
 	return &Courier{
-		baseAggregate: ddd.NewBaseAggregate(id),
-		name:          name,
-		speed:         speed,
-		location:      location,
-		storagePlaces: storagePlaces,
+		baseAggregate:   ddd.RestoreBaseAggregate(id, version),
+		name:            name,
+		vehicle:         vehicle,
+		speed:           speed,
+		location:        location,
+		pendingDistance: pendingDistance,
+		storagePlaces:   storagePlaces,
+		allocator:       allocator,
+		availability:    availability,
+		shift:           shift,
 	}
 }
 
@@ -67,10 +120,26 @@ func (c *Courier) Id() uuid.UUID {
 	return c.baseAggregate.ID()
 }
 
+func (c *Courier) PendingDistance() float64 {
+	return c.pendingDistance
+}
+
+func (c *Courier) Version() int64 {
+	return c.baseAggregate.Version()
+}
+
+func (c *Courier) IncrementVersion() {
+	c.baseAggregate.IncrementVersion()
+}
+
 func (c *Courier) Name() string {
 	return c.name
 }
 
+func (c *Courier) Vehicle() VehicleType {
+	return c.vehicle
+}
+
 func (c *Courier) Speed() int {
 	return c.speed
 }
@@ -83,6 +152,128 @@ func (c *Courier) StoragePlaces() []*StoragePlace {
 	return c.storagePlaces
 }
 
+func (c *Courier) Availability() Availability {
+	return c.availability
+}
+
+// Shift возвращает текущую смену или nil, если курьер не на смене
+func (c *Courier) Shift() *Shift {
+	return c.shift
+}
+
+// IsOnShift проверяет, может ли курьер получать заказы
+func (c *Courier) IsOnShift() bool {
+	return c.availability == AvailabilityOnShift
+}
+
+func (c *Courier) StartShift() error {
+	if c.availability != AvailabilityOffShift {
+		return ErrCourierAlreadyOnShift
+	}
+
+	c.shift = newShift(time.Now().UTC())
+	c.availability = AvailabilityOnShift
+
+	c.RaiseDomainEvent(NewCourierShiftStartedDomainEvent(c))
+
+	return nil
+}
+
+// EndShift завершает смену. Курьер, который везет заказы, закончить смену не может
+func (c *Courier) EndShift() error {
+	if c.availability == AvailabilityOffShift || c.shift == nil {
+		return ErrCourierNotOnShift
+	}
+
+	if c.OrdersCount() > 0 {
+		return ErrCourierHasOrders
+	}
+
+	c.shift.end(time.Now().UTC())
+	c.availability = AvailabilityOffShift
+
+	c.RaiseDomainEvent(NewCourierShiftEndedDomainEvent(c))
+
+	return nil
+}
+
+// StartBreak отправляет курьера на перерыв. Курьер, который везет заказы, уйти на перерыв не может
+func (c *Courier) StartBreak() error {
+	if c.availability != AvailabilityOnShift {
+		return ErrCourierNotOnShift
+	}
+
+	if c.OrdersCount() > 0 {
+		return ErrCourierHasOrders
+	}
+
+	c.availability = AvailabilityOnBreak
+
+	return nil
+}
+
+func (c *Courier) EndBreak() error {
+	if c.availability != AvailabilityOnBreak {
+		return ErrCourierNotOnBreak
+	}
+
+	c.availability = AvailabilityOnShift
+
+	return nil
+}
+
+// OrdersCount возвращает количество заказов, которые везет курьер
+func (c *Courier) OrdersCount() int {
+	count := 0
+	for _, place := range c.storagePlaces {
+		if place.isOccupied() {
+			count++
+		}
+	}
+
+	return count
+}
+
+// FreeVolume возвращает суммарный объем свободных мест хранения
+func (c *Courier) FreeVolume() int {
+	volume := 0
+	for _, place := range c.storagePlaces {
+		if !place.isOccupied() {
+			volume += place.TotalVolume()
+		}
+	}
+
+	return volume
+}
+
+func (c *Courier) AllocationStrategy() AllocationStrategy {
+	return c.allocator.Strategy()
+}
+
+// SetAllocationStrategy меняет стратегию выбора места хранения для новых заказов
+func (c *Courier) SetAllocationStrategy(strategy AllocationStrategy) error {
+	allocator, err := NewStoragePlaceAllocator(strategy)
+	if err != nil {
+		return err
+	}
+
+	c.allocator = allocator
+
+	return nil
+}
+
+func (c *Courier) ClearDomainEvents() {
+	c.baseAggregate.ClearDomainEvents()
+}
+
+func (c *Courier) GetDomainEvents() []ddd.DomainEvent {
+	return c.baseAggregate.GetDomainEvents()
+}
+
+func (c *Courier) RaiseDomainEvent(event ddd.DomainEvent) {
+	c.baseAggregate.RaiseDomainEvent(event)
+}
+
 func (c *Courier) Equals(other *Courier) bool {
 	if other == nil {
 		return false
@@ -91,36 +282,40 @@ func (c *Courier) Equals(other *Courier) bool {
 	return c.baseAggregate.Equal(other.baseAggregate)
 }
 
-func (c *Courier) AddStoragePlace(name string, volume int) error {
-	sp, err := NewStoragePlace(name, volume)
+// AddStoragePlace добавляет место хранения. Если maxWeight равен 0, вес заказа не ограничивается
+func (c *Courier) AddStoragePlace(name string, volume int, maxWeight int) error {
+	sp, err := NewStoragePlace(name, volume, maxWeight)
 	if err != nil {
 		return err
 	}
 
 	c.storagePlaces = append(c.storagePlaces, sp)
 
+	c.RaiseDomainEvent(NewStoragePlaceAddedDomainEvent(c, sp))
+
 	return nil
 }
 
+// CanCarry проверяет, разрешено ли везти заказ на транспорте курьера. Места хранения не учитываются
+func (c *Courier) CanCarry(o *order.Order) bool {
+	if o == nil {
+		return false
+	}
+
+	return c.vehicle.CanCarry(o.Volume(), o.Weight())
+}
+
 func (c *Courier) CanTakeOrder(order *order.Order) (bool, error) {
 	if order == nil {
 		return false, errs.NewValueIsInvalidError("order")
 	}
 
-	for _, place := range c.storagePlaces {
-		if place.orderID != nil {
-			continue
-		}
-
-		can, err := place.CanStore(order.Volume())
-		if err != nil {
-			return false, err
-		}
-
-		return can, nil
+	place, err := c.allocator.Allocate(c.storagePlaces, order.Volume(), order.Weight())
+	if err != nil {
+		return false, err
 	}
 
-	return false, nil
+	return place != nil, nil
 }
 
 func (c *Courier) TakeOrder(order *order.Order) error {
@@ -128,29 +323,21 @@ func (c *Courier) TakeOrder(order *order.Order) error {
 		return errs.NewValueIsInvalidError("order")
 	}
 
-	for _, place := range c.storagePlaces {
-		if place.orderID != nil {
-			continue
-		}
-
-		can, err := place.CanStore(order.Volume())
-		if err != nil {
-			return err
-		}
-
-		if !can {
-			return ErrNoSuitablePlace
-		}
+	place, err := c.allocator.Allocate(c.storagePlaces, order.Volume(), order.Weight())
+	if err != nil {
+		return err
+	}
 
-		err = place.Store(order.ID(), order.Volume())
-		if err != nil {
-			return err
-		}
+	if place == nil {
+		return ErrNoSuitablePlace
+	}
 
-		return nil
+	err = place.Store(order.ID(), order.Volume(), order.Weight())
+	if err != nil {
+		return err
 	}
 
-	return ErrNoSuitablePlace
+	return nil
 }
 
 func (c *Courier) CompleteOrder(order *order.Order) error {
@@ -171,44 +358,143 @@ func (c *Courier) CompleteOrder(order *order.Order) error {
 	return nil
 }
 
-func (c *Courier) CalculateTimeToLocation(target kernel.Location) (float64, error) {
+// ReleaseOrder освобождает место хранения отмененного заказа так же, как при доставке
+func (c *Courier) ReleaseOrder(order *order.Order) error {
+	return c.CompleteOrder(order)
+}
+
+// HasOrder проверяет, везет ли курьер заказ
+func (c *Courier) HasOrder(orderID uuid.UUID) bool {
+	_, err := c.findStoragePlaceByOrderID(orderID)
+	return err == nil
+}
+
+// RouteStop - точка маршрута курьера: место забора или место доставки заказа
+type RouteStop struct {
+	Order    *order.Order
+	Location kernel.Location
+	IsPickup bool
+}
+
+// PlanRoute строит маршрут по заказам курьера: каждой следующей выбирается
+// ближайшая к предыдущей точка. Место доставки заказа попадает в маршрут
+// только после места его забора
+func (c *Courier) PlanRoute(orders []*order.Order) ([]RouteStop, error) {
+	next := make([]RouteStop, 0, len(orders))
+	for _, o := range orders {
+		if o == nil {
+			return nil, errs.NewValueIsInvalidError("order")
+		}
+
+		if !c.HasOrder(o.ID()) {
+			return nil, ErrOrderNotFound
+		}
+
+		switch o.Status() {
+		case order.StatusAssigned:
+			next = append(next, RouteStop{Order: o, Location: o.PickupLocation(), IsPickup: true})
+		case order.StatusPickedUp:
+			next = append(next, RouteStop{Order: o, Location: o.Location()})
+		default:
+			return nil, order.ErrInvalidOrderStatus
+		}
+	}
+
+	metric := kernel.CurrentDistanceMetric()
+	route := make([]RouteStop, 0, 2*len(next))
+	current := c.location
+	for len(next) > 0 {
+		nearest := 0
+		for i, stop := range next {
+			if metric.Distance(current, stop.Location) < metric.Distance(current, next[nearest].Location) {
+				nearest = i
+			}
+		}
+
+		stop := next[nearest]
+		route = append(route, stop)
+		current = stop.Location
+
+		if stop.IsPickup {
+			next[nearest] = RouteStop{Order: stop.Order, Location: stop.Order.Location()}
+		} else {
+			next = append(next[:nearest], next[nearest+1:]...)
+		}
+	}
+
+	return route, nil
+}
+
+// EstimateTimeToLocation возвращает время в пути до target
+func (c *Courier) EstimateTimeToLocation(target kernel.Location) (time.Duration, error) {
 	if !target.IsValid() {
 		return 0, errs.NewValueIsInvalidError("target")
 	}
 
-	d := c.location.DistanceTo(target)
+	return c.travelTime(kernel.CurrentDistanceMetric().Distance(c.location, target)), nil
+}
 
-	t := float64(d) / float64(c.speed)
+// EstimateTimeToDeliver возвращает время, через которое курьер доставит заказ, если поедет к нему сразу.
+// Для забранного заказа учитывается только путь до места доставки
+func (c *Courier) EstimateTimeToDeliver(o *order.Order) (time.Duration, error) {
+	if o == nil {
+		return 0, errs.NewValueIsInvalidError("order")
+	}
 
-	return t, nil
+	metric := kernel.CurrentDistanceMetric()
+	if o.Status() == order.StatusPickedUp {
+		return c.travelTime(metric.Distance(c.location, o.Location())), nil
+	}
+
+	return c.travelTime(metric.Distance(c.location, o.PickupLocation()) + o.DeliveryDistance()), nil
 }
 
+func (c *Courier) travelTime(distance float64) time.Duration {
+	return kernel.CurrentDistanceMetric().TravelTime(c.speed, distance)
+}
+
+// Move перемещает курьера к target на расстояние, равное его скорости
 func (c *Courier) Move(target kernel.Location) error {
+	return c.moveBy(target, float64(c.speed))
+}
+
+// MoveFor перемещает курьера к target на расстояние, которое он проходит за elapsed
+func (c *Courier) MoveFor(target kernel.Location, elapsed time.Duration) error {
+	if elapsed < 0 {
+		return errs.NewValueIsInvalidError("elapsed")
+	}
+
+	return c.moveBy(target, kernel.CurrentDistanceMetric().TravelDistance(c.speed, elapsed))
+}
+
+func (c *Courier) moveBy(target kernel.Location, distance float64) error {
 	if !target.IsValid() {
 		return errs.NewValueIsRequiredError("target")
 	}
 
-	dx := float64(target.X() - c.location.X())
-	dy := float64(target.Y() - c.location.Y())
-	remainingRange := float64(c.speed)
+	metric := kernel.CurrentDistanceMetric()
+	distance += c.pendingDistance
 
-	if math.Abs(dx) > remainingRange {
-		dx = math.Copysign(remainingRange, dx)
+	newLocation, err := metric.Advance(c.location, target, distance)
+	if err != nil {
+		return err
 	}
-	remainingRange -= math.Abs(dx)
 
-	if math.Abs(dy) > remainingRange {
-		dy = math.Copysign(remainingRange, dy)
+	// остаток пути переносится на следующий шаг, пока курьер не доберется до цели
+	c.pendingDistance = 0
+	if !newLocation.Equals(target) {
+		c.pendingDistance = distance - metric.Distance(c.location, newLocation)
 	}
 
-	newX := c.location.X() + int(dx)
-	newY := c.location.Y() + int(dy)
-
-	newLocation, err := kernel.NewLocation(newX, newY)
-	if err != nil {
-		return err
+	if newLocation.Equals(c.location) {
+		return nil
 	}
+
+	from := c.location
 	c.location = newLocation
+
+	c.RaiseDomainEvent(NewCourierMovedDomainEvent(c, from))
+
 	return nil
 }
 
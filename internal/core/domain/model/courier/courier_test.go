@@ -6,6 +6,7 @@ import (
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -82,6 +83,107 @@ func TestNewCourier(t *testing.T) {
 	}
 }
 
+func TestNewCourierWithVehicle(t *testing.T) {
+	tests := []struct {
+		vehicle courier.VehicleType
+		volumes []int
+	}{
+		{vehicle: courier.VehicleFoot, volumes: []int{10}},
+		{vehicle: courier.VehicleBicycle, volumes: []int{10, 10}},
+		{vehicle: courier.VehicleScooter, volumes: []int{20}},
+		{vehicle: courier.VehicleCar, volumes: []int{50, 30}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.vehicle.String(), func(t *testing.T) {
+			got, err := courier.NewCourierWithVehicle("Courier", tt.vehicle, tt.vehicle.DefaultSpeed(), kernel.MinLocation())
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.vehicle, got.Vehicle())
+			assert.Equal(t, tt.vehicle.DefaultSpeed(), got.Speed())
+
+			volumes := make([]int, 0, len(got.StoragePlaces()))
+			for _, sp := range got.StoragePlaces() {
+				volumes = append(volumes, sp.TotalVolume())
+			}
+			assert.Equal(t, tt.volumes, volumes)
+		})
+	}
+
+	_, err := courier.NewCourierWithVehicle("Courier", "plane", 1, kernel.MinLocation())
+	assert.Equal(t, errs.NewValueIsInvalidError("vehicle").Error(), err.Error())
+}
+
+func TestCourier_CanCarry(t *testing.T) {
+	heavy, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 40)
+	require.NoError(t, err)
+
+	light, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+
+	for _, vehicle := range []courier.VehicleType{courier.VehicleFoot, courier.VehicleBicycle, courier.VehicleScooter} {
+		c, err := courier.NewCourierWithVehicle("Courier", vehicle, 1, kernel.MinLocation())
+		require.NoError(t, err)
+		require.NoError(t, c.AddStoragePlace("Trunk", 100, 0))
+
+		assert.True(t, c.CanCarry(light), vehicle)
+		assert.False(t, c.CanCarry(heavy), vehicle)
+	}
+
+	car, err := courier.NewCourierWithVehicle("Courier", courier.VehicleCar, 1, kernel.MinLocation())
+	require.NoError(t, err)
+	assert.True(t, car.CanCarry(heavy))
+	assert.False(t, car.CanCarry(nil))
+
+	details, err := order.NewDetails(12000, order.Dimensions{}, nil)
+	require.NoError(t, err)
+	weighty, err := order.NewOrderWithDetails(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5, details)
+	require.NoError(t, err)
+
+	scooter, err := courier.NewCourierWithVehicle("Courier", courier.VehicleScooter, 1, kernel.MinLocation())
+	require.NoError(t, err)
+	bicycle, err := courier.NewCourierWithVehicle("Courier", courier.VehicleBicycle, 1, kernel.MinLocation())
+	require.NoError(t, err)
+
+	assert.True(t, scooter.CanCarry(weighty))
+	assert.False(t, bicycle.CanCarry(weighty))
+
+	// крупный заказ пешком не везут, даже если он помещается в сумку
+	bulky, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 8)
+	require.NoError(t, err)
+	walker, err := courier.NewCourierWithVehicle("Courier", courier.VehicleFoot, 1, kernel.MinLocation())
+	require.NoError(t, err)
+
+	fits, err := walker.CanTakeOrder(bulky)
+	require.NoError(t, err)
+	assert.True(t, fits)
+	assert.False(t, walker.CanCarry(bulky))
+}
+
+func TestCourier_TakeOrder_RespectsWeightLimits(t *testing.T) {
+	c, err := courier.NewCourierWithVehicle("Courier", courier.VehicleBicycle, 1, kernel.MinLocation())
+	require.NoError(t, err)
+
+	details, err := order.NewDetails(7000, order.Dimensions{}, nil)
+	require.NoError(t, err)
+	o, err := order.NewOrderWithDetails(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5, details)
+	require.NoError(t, err)
+
+	// в сумку вес не помещается, заказ уезжает в корзину
+	require.NoError(t, c.TakeOrder(o))
+	for _, sp := range c.StoragePlaces() {
+		if sp.OrderID() != nil {
+			assert.Equal(t, "Basket", sp.Name())
+		}
+	}
+
+	details, err = order.NewDetails(6000, order.Dimensions{}, nil)
+	require.NoError(t, err)
+	another, err := order.NewOrderWithDetails(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5, details)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, c.TakeOrder(another), courier.ErrNoSuitablePlace)
+}
+
 func TestCourier_CanTakeOrder(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -102,7 +204,7 @@ func TestCourier_CanTakeOrder(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			o, err := order.NewOrder(uuid.New(), kernel.RandomLocation(), tt.orderVolume)
+			o, err := order.NewOrder(uuid.New(), kernel.RandomLocation(), kernel.RandomLocation(), tt.orderVolume)
 			require.Nil(t, err)
 
 			c, err := courier.NewCourier("Courier", 2, kernel.RandomLocation())
@@ -145,7 +247,7 @@ func TestCourier_TakeOrder(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			o, err := order.NewOrder(uuid.New(), kernel.RandomLocation(), tt.orderVolume)
+			o, err := order.NewOrder(uuid.New(), kernel.RandomLocation(), kernel.RandomLocation(), tt.orderVolume)
 			require.Nil(t, err)
 
 			c, err := courier.NewCourier("Courier", 2, kernel.RandomLocation())
@@ -164,31 +266,268 @@ func TestCourier_TakeOrder(t *testing.T) {
 	}
 }
 
-func TestCourier_CalculateTimeToLocation(t *testing.T) {
+func TestCourier_TakeOrder_MixedSizeBags(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, kernel.RandomLocation())
+	require.NoError(t, err)
+	require.NoError(t, c.AddStoragePlace("Pocket", 2, 0))
+	require.NoError(t, c.AddStoragePlace("Trunk", 30, 0))
+
+	small, err := order.NewOrder(uuid.New(), kernel.RandomLocation(), kernel.RandomLocation(), 1)
+	require.NoError(t, err)
+	medium, err := order.NewOrder(uuid.New(), kernel.RandomLocation(), kernel.RandomLocation(), 8)
+	require.NoError(t, err)
+	large, err := order.NewOrder(uuid.New(), kernel.RandomLocation(), kernel.RandomLocation(), 25)
+	require.NoError(t, err)
+
+	// Заказы раскладываются по наименьшим подходящим местам
+	require.NoError(t, c.TakeOrder(small))
+	require.NoError(t, c.TakeOrder(medium))
+	require.NoError(t, c.TakeOrder(large))
+
+	places := c.StoragePlaces()
+	assert.Equal(t, medium.ID(), *places[0].OrderID())
+	assert.Equal(t, small.ID(), *places[1].OrderID())
+	assert.Equal(t, large.ID(), *places[2].OrderID())
+
+	another, err := order.NewOrder(uuid.New(), kernel.RandomLocation(), kernel.RandomLocation(), 1)
+	require.NoError(t, err)
+
+	can, err := c.CanTakeOrder(another)
+	require.NoError(t, err)
+	assert.False(t, can)
+	assert.ErrorIs(t, c.TakeOrder(another), courier.ErrNoSuitablePlace)
+}
+
+func TestCourier_CanTakeOrder_SkipsTooSmallPlace(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, kernel.RandomLocation())
+	require.NoError(t, err)
+	require.NoError(t, c.AddStoragePlace("Trunk", 30, 0))
+
+	o, err := order.NewOrder(uuid.New(), kernel.RandomLocation(), kernel.RandomLocation(), 20)
+	require.NoError(t, err)
+
+	can, err := c.CanTakeOrder(o)
+	require.NoError(t, err)
+	assert.True(t, can)
+
+	require.NoError(t, c.TakeOrder(o))
+	assert.Nil(t, c.StoragePlaces()[0].OrderID())
+	assert.Equal(t, o.ID(), *c.StoragePlaces()[1].OrderID())
+}
+
+func TestCourier_TakeOrder_FirstFitAllocator(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, kernel.RandomLocation())
+	require.NoError(t, err)
+	require.NoError(t, c.AddStoragePlace("Pocket", 2, 0))
+	require.NoError(t, c.SetAllocationStrategy(courier.AllocationFirstFit))
+
+	o, err := order.NewOrder(uuid.New(), kernel.RandomLocation(), kernel.RandomLocation(), 1)
+	require.NoError(t, err)
+
+	require.NoError(t, c.TakeOrder(o))
+	assert.Equal(t, o.ID(), *c.StoragePlaces()[0].OrderID())
+	assert.Nil(t, c.StoragePlaces()[1].OrderID())
+}
+
+func TestCourier_SetAllocationStrategy_Unknown(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, kernel.RandomLocation())
+	require.NoError(t, err)
+
+	err = c.SetAllocationStrategy("worst-fit")
+	assert.Equal(t, errs.NewValueIsInvalidError("allocation strategy").Error(), err.Error())
+	assert.Equal(t, courier.AllocationBestFit, c.AllocationStrategy())
+}
+
+func TestRestoreCourier_AllocationStrategy(t *testing.T) {
+	tests := []struct {
+		name       string
+		allocation courier.AllocationStrategy
+		want       courier.AllocationStrategy
+	}{
+		{name: "first fit", allocation: courier.AllocationFirstFit, want: courier.AllocationFirstFit},
+		{name: "best fit", allocation: courier.AllocationBestFit, want: courier.AllocationBestFit},
+		{name: "unknown", allocation: "worst-fit", want: courier.AllocationBestFit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := courier.RestoreCourier(uuid.New(), "Courier", courier.VehicleFoot, 2, kernel.RandomLocation(),
+				nil, courier.AvailabilityOnShift, nil, tt.allocation, 0, 1)
+
+			assert.Equal(t, tt.want, c.AllocationStrategy())
+		})
+	}
+}
+
+func TestCourier_PlanRoute(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+	require.NoError(t, c.AddStoragePlace("Trunk", 40, 0))
+	require.NoError(t, c.AddStoragePlace("Box", 20, 0))
+
+	far, err := order.NewOrder(uuid.New(), createValidLocation(10, 10), createValidLocation(10, 10), 5)
+	require.NoError(t, err)
+	near, err := order.NewOrder(uuid.New(), createValidLocation(2, 2), createValidLocation(2, 2), 5)
+	require.NoError(t, err)
+	middle, err := order.NewOrder(uuid.New(), createValidLocation(5, 5), createValidLocation(5, 5), 5)
+	require.NoError(t, err)
+
+	for _, o := range []*order.Order{far, near, middle} {
+		require.NoError(t, c.TakeOrder(o))
+		require.NoError(t, o.Assign(c.Id()))
+		require.NoError(t, o.PickUp())
+	}
+
+	route, err := c.PlanRoute([]*order.Order{far, near, middle})
+	require.NoError(t, err)
+	assert.Equal(t, []courier.RouteStop{
+		{Order: near, Location: near.Location()},
+		{Order: middle, Location: middle.Location()},
+		{Order: far, Location: far.Location()},
+	}, route)
+}
+
+func TestCourier_PlanRoute_PickupBeforeDelivery(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+
+	// место доставки ближе к курьеру, чем место забора
+	o, err := order.NewOrder(uuid.New(), createValidLocation(8, 8), createValidLocation(2, 2), 5)
+	require.NoError(t, err)
+	require.NoError(t, c.TakeOrder(o))
+	require.NoError(t, o.Assign(c.Id()))
+
+	route, err := c.PlanRoute([]*order.Order{o})
+	require.NoError(t, err)
+	assert.Equal(t, []courier.RouteStop{
+		{Order: o, Location: o.PickupLocation(), IsPickup: true},
+		{Order: o, Location: o.Location()},
+	}, route)
+}
+
+func TestCourier_PlanRoute_NotAssignedOrder(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+
+	o, err := order.NewOrder(uuid.New(), createValidLocation(2, 2), createValidLocation(2, 2), 5)
+	require.NoError(t, err)
+	require.NoError(t, c.TakeOrder(o))
+
+	_, err = c.PlanRoute([]*order.Order{o})
+	assert.ErrorIs(t, err, order.ErrInvalidOrderStatus)
+}
+
+func TestCourier_EstimateTimeToLocation(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+
+	got, err := c.EstimateTimeToLocation(createValidLocation(4, 3))
+	require.NoError(t, err)
+	assert.Equal(t, 3*kernel.StepDuration, got)
+
+	_, err = c.EstimateTimeToLocation(kernel.Location{})
+	assert.Error(t, err)
+}
+
+func TestCourier_EstimateTimeToDeliver(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+
+	o, err := order.NewOrder(uuid.New(), createValidLocation(5, 1), createValidLocation(5, 6), 5)
+	require.NoError(t, err)
+
+	// 4 клетки до места забора и 5 до места доставки - 5 шагов со скоростью 2
+	got, err := c.EstimateTimeToDeliver(o)
+	require.NoError(t, err)
+	assert.Equal(t, 5*kernel.StepDuration, got)
+
+	require.NoError(t, c.TakeOrder(o))
+	require.NoError(t, o.Assign(c.Id()))
+	require.NoError(t, o.PickUp())
+
+	// после забора остается путь от курьера до места доставки
+	got, err = c.EstimateTimeToDeliver(o)
+	require.NoError(t, err)
+	assert.Equal(t, 5*kernel.StepDuration, got)
+
+	_, err = c.EstimateTimeToDeliver(nil)
+	assert.Error(t, err)
+}
+
+func TestCourier_PlanRoute_ForeignOrder(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+
+	o, err := order.NewOrder(uuid.New(), createValidLocation(2, 2), createValidLocation(2, 2), 5)
+	require.NoError(t, err)
+
+	_, err = c.PlanRoute([]*order.Order{o})
+	assert.ErrorIs(t, err, courier.ErrOrderNotFound)
+}
+
+func TestCourier_CompleteOrder_FreesOnlyMatchingPlace(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+	require.NoError(t, c.AddStoragePlace("Trunk", 40, 0))
+
+	small, err := order.NewOrder(uuid.New(), createValidLocation(2, 2), createValidLocation(2, 2), 5)
+	require.NoError(t, err)
+	large, err := order.NewOrder(uuid.New(), createValidLocation(3, 3), createValidLocation(3, 3), 30)
+	require.NoError(t, err)
+
+	require.NoError(t, c.TakeOrder(small))
+	require.NoError(t, c.TakeOrder(large))
+
+	require.NoError(t, c.CompleteOrder(large))
+	assert.True(t, c.HasOrder(small.ID()))
+	assert.False(t, c.HasOrder(large.ID()))
+}
+
+func TestCourier_ReleaseOrder(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+	require.NoError(t, c.AddStoragePlace("Trunk", 40, 0))
+
+	kept, err := order.NewOrder(uuid.New(), createValidLocation(2, 2), createValidLocation(2, 2), 5)
+	require.NoError(t, err)
+	canceled, err := order.NewOrder(uuid.New(), createValidLocation(3, 3), createValidLocation(3, 3), 30)
+	require.NoError(t, err)
+
+	require.NoError(t, c.TakeOrder(kept))
+	require.NoError(t, c.TakeOrder(canceled))
+
+	require.NoError(t, c.ReleaseOrder(canceled))
+	assert.True(t, c.HasOrder(kept.ID()))
+	assert.False(t, c.HasOrder(canceled.ID()))
+
+	assert.ErrorIs(t, c.ReleaseOrder(canceled), courier.ErrOrderNotFound)
+	assert.Error(t, c.ReleaseOrder(nil))
+}
+
+func TestCourier_EstimateTimeToLocation_Steps(t *testing.T) {
 	tests := []struct {
 		name            string
 		courierLocation kernel.Location
 		orderLocation   kernel.Location
-		want            float64
-		wantErr         error
+		want            time.Duration
 	}{
 		{
 			name:            "Distance 1",
 			courierLocation: createValidLocation(1, 1),
 			orderLocation:   createValidLocation(2, 2),
-			want:            1,
+			want:            1 * kernel.StepDuration,
 		},
 		{
-			name:            "Distance 3.5",
+			name:            "Distance 3.5 takes 4 steps",
 			courierLocation: createValidLocation(1, 1),
 			orderLocation:   createValidLocation(4, 5),
-			want:            3.5,
+			want:            4 * kernel.StepDuration,
 		},
 		{
 			name:            "Distance 9",
 			courierLocation: createValidLocation(1, 1),
 			orderLocation:   createValidLocation(10, 10),
-			want:            9,
+			want:            9 * kernel.StepDuration,
 		},
 
 		{
@@ -204,7 +543,7 @@ func TestCourier_CalculateTimeToLocation(t *testing.T) {
 			c, err := courier.NewCourier("Courier", 2, tt.courierLocation)
 			require.Nil(t, err)
 
-			got, err := c.CalculateTimeToLocation(tt.orderLocation)
+			got, err := c.EstimateTimeToLocation(tt.orderLocation)
 			require.Nil(t, err)
 			require.Equal(t, tt.want, got)
 		})
@@ -219,3 +558,198 @@ func createValidLocation(x, y int) kernel.Location {
 
 	return l
 }
+
+func TestNewCourier_RaisesDomainEvents(t *testing.T) {
+	location := createValidLocation(2, 3)
+	c, err := courier.NewCourier("Courier", 2, location)
+	require.Nil(t, err)
+
+	events := c.GetDomainEvents()
+	require.Len(t, events, 2)
+
+	created, ok := events[0].(courier.CourierCreatedDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, "CourierCreatedDomainEvent", created.GetName())
+	assert.Equal(t, c.Id(), created.CourierID)
+	assert.Equal(t, "Courier", created.Name)
+	assert.Equal(t, 2, created.Speed)
+	assert.Equal(t, 2, created.LocationX)
+	assert.Equal(t, 3, created.LocationY)
+
+	added, ok := events[1].(courier.StoragePlaceAddedDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, c.Id(), added.CourierID)
+	assert.Equal(t, c.StoragePlaces()[0].Id(), added.StoragePlaceID)
+	assert.Equal(t, "Bag", added.Name)
+	assert.Equal(t, 10, added.TotalVolume)
+}
+
+func TestCourier_Move_RaisesMovedDomainEvent(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.Nil(t, err)
+	c.ClearDomainEvents()
+
+	err = c.Move(createValidLocation(5, 5))
+	require.Nil(t, err)
+
+	events := c.GetDomainEvents()
+	require.Len(t, events, 1)
+
+	moved, ok := events[0].(courier.CourierMovedDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, c.Id(), moved.CourierID)
+	assert.Equal(t, 1, moved.FromX)
+	assert.Equal(t, 1, moved.FromY)
+	assert.Equal(t, 3, moved.ToX)
+	assert.Equal(t, 1, moved.ToY)
+}
+
+func TestCourier_Move_AtTarget_DoesNotRaiseDomainEvent(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(4, 4))
+	require.Nil(t, err)
+	c.ClearDomainEvents()
+
+	err = c.Move(createValidLocation(4, 4))
+	require.Nil(t, err)
+
+	assert.Empty(t, c.GetDomainEvents())
+}
+
+func TestCourier_MoveFor(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+
+	// на сетке скорость задается в клетках за шаг, время не учитывается
+	require.NoError(t, c.MoveFor(createValidLocation(1, 9), time.Hour))
+	assert.Equal(t, createValidLocation(1, 3), c.Location())
+
+	assert.Error(t, c.MoveFor(createValidLocation(1, 9), -time.Second))
+}
+
+func TestCourier_MoveFor_Haversine(t *testing.T) {
+	setHaversineMetric(t, 0.5)
+
+	// 2 км/ч за 30 минут - 1 км, то есть две клетки по 0.5 км
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+
+	require.NoError(t, c.MoveFor(createValidLocation(1, 9), 30*time.Minute))
+	assert.Equal(t, createValidLocation(1, 3), c.Location())
+
+	// остается 3 км со скоростью 2 км/ч
+	got, err := c.EstimateTimeToLocation(createValidLocation(1, 9))
+	require.NoError(t, err)
+	assert.InDelta(t, float64(90*time.Minute), float64(got), float64(time.Millisecond))
+}
+
+func TestCourier_MoveFor_HaversineAccumulatesSubCellDistance(t *testing.T) {
+	setHaversineMetric(t, 0.5)
+	metric := kernel.CurrentDistanceMetric()
+
+	// 18 км/ч за секунду - 5 метров, на клетку в 0.5 км уходит 100 шагов
+	start := createValidLocation(1, 1)
+	c, err := courier.NewCourier("Courier", 18, start)
+	require.NoError(t, err)
+
+	require.NoError(t, c.MoveFor(createValidLocation(1, 9), time.Second))
+	assert.Equal(t, start, c.Location())
+	assert.InDelta(t, 0.005, c.PendingDistance(), 1e-9)
+
+	for tick := 2; tick <= 600; tick++ {
+		require.NoError(t, c.MoveFor(createValidLocation(1, 9), time.Second))
+
+		// положение отличается от пройденного пути не больше, чем на половину клетки
+		travelled := float64(tick) * 0.005
+		assert.InDelta(t, travelled, metric.Distance(start, c.Location()), 0.25+0.005)
+	}
+
+	assert.Equal(t, createValidLocation(1, 7), c.Location())
+}
+
+func setHaversineMetric(t *testing.T, cellSizeKm float64) {
+	t.Helper()
+
+	origin, err := kernel.NewGeoPoint(55.751244, 37.618423)
+	require.NoError(t, err)
+	projection, err := kernel.NewGeoProjection(origin, cellSizeKm, kernel.DefaultGrid())
+	require.NoError(t, err)
+	metric, err := kernel.NewHaversineMetric(projection)
+	require.NoError(t, err)
+
+	previous := kernel.CurrentDistanceMetric()
+	require.NoError(t, kernel.SetDistanceMetric(metric))
+	t.Cleanup(func() {
+		require.NoError(t, kernel.SetDistanceMetric(previous))
+	})
+}
+
+func TestCourier_Shift(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+	c.ClearDomainEvents()
+
+	assert.Equal(t, courier.AvailabilityOffShift, c.Availability())
+	assert.False(t, c.IsOnShift())
+	assert.Nil(t, c.Shift())
+	assert.ErrorIs(t, c.EndShift(), courier.ErrCourierNotOnShift)
+	assert.ErrorIs(t, c.StartBreak(), courier.ErrCourierNotOnShift)
+
+	require.NoError(t, c.StartShift())
+	assert.True(t, c.IsOnShift())
+	require.NotNil(t, c.Shift())
+	assert.True(t, c.Shift().IsOpen())
+	assert.ErrorIs(t, c.StartShift(), courier.ErrCourierAlreadyOnShift)
+
+	require.NoError(t, c.EndShift())
+	assert.Equal(t, courier.AvailabilityOffShift, c.Availability())
+	require.NotNil(t, c.Shift().EndedAt())
+	assert.False(t, c.Shift().EndedAt().Before(c.Shift().StartedAt()))
+
+	events := c.GetDomainEvents()
+	require.Len(t, events, 2)
+
+	started, ok := events[0].(courier.CourierShiftStartedDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, c.Id(), started.CourierID)
+	assert.Equal(t, c.Shift().Id(), started.ShiftID)
+
+	ended, ok := events[1].(courier.CourierShiftEndedDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, c.Shift().Id(), ended.ShiftID)
+	assert.Equal(t, *c.Shift().EndedAt(), ended.EndedAt)
+}
+
+func TestCourier_Break(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+	require.NoError(t, c.StartShift())
+
+	assert.ErrorIs(t, c.EndBreak(), courier.ErrCourierNotOnBreak)
+
+	require.NoError(t, c.StartBreak())
+	assert.Equal(t, courier.AvailabilityOnBreak, c.Availability())
+	assert.False(t, c.IsOnShift())
+	assert.ErrorIs(t, c.StartShift(), courier.ErrCourierAlreadyOnShift)
+
+	require.NoError(t, c.EndBreak())
+	assert.True(t, c.IsOnShift())
+
+	// смену можно закончить и с перерыва
+	require.NoError(t, c.StartBreak())
+	require.NoError(t, c.EndShift())
+	assert.Equal(t, courier.AvailabilityOffShift, c.Availability())
+}
+
+func TestCourier_ShiftWithOrders(t *testing.T) {
+	c, err := courier.NewCourier("Courier", 2, createValidLocation(1, 1))
+	require.NoError(t, err)
+	require.NoError(t, c.StartShift())
+
+	o, err := order.NewOrder(uuid.New(), createValidLocation(2, 2), createValidLocation(2, 2), 5)
+	require.NoError(t, err)
+	require.NoError(t, c.TakeOrder(o))
+
+	assert.ErrorIs(t, c.EndShift(), courier.ErrCourierHasOrders)
+	assert.ErrorIs(t, c.StartBreak(), courier.ErrCourierHasOrders)
+	assert.True(t, c.IsOnShift())
+}
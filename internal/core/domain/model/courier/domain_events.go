@@ -0,0 +1,113 @@
+package courier
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/ddd"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ ddd.DomainEvent = CourierCreatedDomainEvent{}
+	_ ddd.DomainEvent = CourierMovedDomainEvent{}
+	_ ddd.DomainEvent = StoragePlaceAddedDomainEvent{}
+	_ ddd.DomainEvent = CourierShiftStartedDomainEvent{}
+	_ ddd.DomainEvent = CourierShiftEndedDomainEvent{}
+)
+
+type CourierCreatedDomainEvent struct {
+	ddd.BaseDomainEvent
+	CourierID uuid.UUID
+	Name      string
+	Vehicle   string
+	Speed     int
+	LocationX int
+	LocationY int
+}
+
+func NewCourierCreatedDomainEvent(aggregate *Courier) CourierCreatedDomainEvent {
+	return CourierCreatedDomainEvent{
+		BaseDomainEvent: ddd.NewBaseDomainEvent("CourierCreatedDomainEvent"),
+		CourierID:       aggregate.Id(),
+		Name:            aggregate.Name(),
+		Vehicle:         aggregate.Vehicle().String(),
+		Speed:           aggregate.Speed(),
+		LocationX:       aggregate.Location().X(),
+		LocationY:       aggregate.Location().Y(),
+	}
+}
+
+type CourierMovedDomainEvent struct {
+	ddd.BaseDomainEvent
+	CourierID uuid.UUID
+	FromX     int
+	FromY     int
+	ToX       int
+	ToY       int
+}
+
+func NewCourierMovedDomainEvent(aggregate *Courier, from kernel.Location) CourierMovedDomainEvent {
+	return CourierMovedDomainEvent{
+		BaseDomainEvent: ddd.NewBaseDomainEvent("CourierMovedDomainEvent"),
+		CourierID:       aggregate.Id(),
+		FromX:           from.X(),
+		FromY:           from.Y(),
+		ToX:             aggregate.Location().X(),
+		ToY:             aggregate.Location().Y(),
+	}
+}
+
+type StoragePlaceAddedDomainEvent struct {
+	ddd.BaseDomainEvent
+	CourierID      uuid.UUID
+	StoragePlaceID uuid.UUID
+	Name           string
+	TotalVolume    int
+	MaxWeight      int
+}
+
+func NewStoragePlaceAddedDomainEvent(aggregate *Courier, storagePlace *StoragePlace) StoragePlaceAddedDomainEvent {
+	return StoragePlaceAddedDomainEvent{
+		BaseDomainEvent: ddd.NewBaseDomainEvent("StoragePlaceAddedDomainEvent"),
+		CourierID:       aggregate.Id(),
+		StoragePlaceID:  storagePlace.Id(),
+		Name:            storagePlace.Name(),
+		TotalVolume:     storagePlace.TotalVolume(),
+		MaxWeight:       storagePlace.MaxWeight(),
+	}
+}
+
+type CourierShiftStartedDomainEvent struct {
+	ddd.BaseDomainEvent
+	CourierID uuid.UUID
+	ShiftID   uuid.UUID
+	StartedAt time.Time
+}
+
+func NewCourierShiftStartedDomainEvent(aggregate *Courier) CourierShiftStartedDomainEvent {
+	return CourierShiftStartedDomainEvent{
+		BaseDomainEvent: ddd.NewBaseDomainEvent("CourierShiftStartedDomainEvent"),
+		CourierID:       aggregate.Id(),
+		ShiftID:         aggregate.Shift().Id(),
+		StartedAt:       aggregate.Shift().StartedAt(),
+	}
+}
+
+type CourierShiftEndedDomainEvent struct {
+	ddd.BaseDomainEvent
+	CourierID uuid.UUID
+	ShiftID   uuid.UUID
+	StartedAt time.Time
+	EndedAt   time.Time
+}
+
+func NewCourierShiftEndedDomainEvent(aggregate *Courier) CourierShiftEndedDomainEvent {
+	return CourierShiftEndedDomainEvent{
+		BaseDomainEvent: ddd.NewBaseDomainEvent("CourierShiftEndedDomainEvent"),
+		CourierID:       aggregate.Id(),
+		ShiftID:         aggregate.Shift().Id(),
+		StartedAt:       aggregate.Shift().StartedAt(),
+		EndedAt:         *aggregate.Shift().EndedAt(),
+	}
+}
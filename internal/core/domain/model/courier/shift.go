@@ -0,0 +1,50 @@
+package courier
+
+import (
+	"delivery/internal/pkg/ddd"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Shift - рабочая смена курьера, по ней считается оплата
+type Shift struct {
+	baseEntity *ddd.BaseEntity[uuid.UUID]
+	startedAt  time.Time
+	endedAt    *time.Time
+}
+
+func newShift(startedAt time.Time) *Shift {
+	return &Shift{
+		baseEntity: ddd.NewBaseEntity(uuid.New()),
+		startedAt:  startedAt,
+	}
+}
+
+func RestoreShift(id uuid.UUID, startedAt time.Time, endedAt *time.Time) *Shift {
+	return &Shift{
+		baseEntity: ddd.NewBaseEntity(id),
+		startedAt:  startedAt,
+		endedAt:    endedAt,
+	}
+}
+
+func (s *Shift) Id() uuid.UUID {
+	return s.baseEntity.ID()
+}
+
+func (s *Shift) StartedAt() time.Time {
+	return s.startedAt
+}
+
+func (s *Shift) EndedAt() *time.Time {
+	return s.endedAt
+}
+
+func (s *Shift) IsOpen() bool {
+	return s.endedAt == nil
+}
+
+func (s *Shift) end(endedAt time.Time) {
+	s.endedAt = &endedAt
+}
@@ -18,10 +18,13 @@ type StoragePlace struct {
 	baseEntity  *ddd.BaseEntity[uuid.UUID]
 	name        string
 	totalVolume int
-	orderID     *uuid.UUID
+	// maxWeight - допустимый вес заказа в граммах, 0 - без ограничений
+	maxWeight int
+	orderID   *uuid.UUID
 }
 
-func NewStoragePlace(name string, totalVolume int) (*StoragePlace, error) {
+// NewStoragePlace создает место хранения. Если maxWeight равен 0, вес заказа не ограничивается
+func NewStoragePlace(name string, totalVolume int, maxWeight int) (*StoragePlace, error) {
 	if strings.TrimSpace(name) == "" {
 		return nil, errs.NewValueIsInvalidError("name")
 	}
@@ -30,18 +33,24 @@ func NewStoragePlace(name string, totalVolume int) (*StoragePlace, error) {
 		return nil, errs.NewValueIsInvalidError("totalVolume")
 	}
 
+	if maxWeight < 0 {
+		return nil, errs.NewValueIsInvalidError("maxWeight")
+	}
+
 	return &StoragePlace{
 		baseEntity:  ddd.NewBaseEntity(uuid.New()),
 		name:        name,
 		totalVolume: totalVolume,
+		maxWeight:   maxWeight,
 	}, nil
 }
 
-func RestoreStoragePlace(id uuid.UUID, name string, totalVolume int, orderID *uuid.UUID) *StoragePlace {
+func RestoreStoragePlace(id uuid.UUID, name string, totalVolume int, maxWeight int, orderID *uuid.UUID) *StoragePlace {
 	return &StoragePlace{
 		baseEntity:  ddd.NewBaseEntity(id),
 		name:        name,
 		totalVolume: totalVolume,
+		maxWeight:   maxWeight,
 		orderID:     orderID,
 	}
 }
@@ -58,6 +67,10 @@ func (s *StoragePlace) TotalVolume() int {
 	return s.totalVolume
 }
 
+func (s *StoragePlace) MaxWeight() int {
+	return s.maxWeight
+}
+
 func (s *StoragePlace) OrderID() *uuid.UUID {
 	return s.orderID
 }
@@ -70,24 +83,33 @@ func (s *StoragePlace) Equals(other *StoragePlace) bool {
 	return s.baseEntity.Equal(other.baseEntity)
 }
 
-func (s *StoragePlace) CanStore(volume int) (bool, error) {
+// CanStore проверяет, поместится ли заказ объемом volume и весом weight. Нулевой вес - вес неизвестен
+func (s *StoragePlace) CanStore(volume int, weight int) (bool, error) {
 	if volume <= 0 {
 		return false, errs.NewValueIsInvalidError("volume")
 	}
 
+	if weight < 0 {
+		return false, errs.NewValueIsInvalidError("weight")
+	}
+
 	if s.isOccupied() {
 		return false, nil
 	}
 
+	if s.maxWeight > 0 && weight > s.maxWeight {
+		return false, nil
+	}
+
 	return volume <= s.totalVolume, nil
 }
 
-func (s *StoragePlace) Store(orderID uuid.UUID, volume int) error {
+func (s *StoragePlace) Store(orderID uuid.UUID, volume int, weight int) error {
 	if orderID == uuid.Nil {
 		return errs.NewValueIsInvalidError("orderID")
 	}
 
-	canStore, err := s.CanStore(volume)
+	canStore, err := s.CanStore(volume, weight)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,101 @@
+package courier
+
+import "delivery/internal/pkg/errs"
+
+// StoragePlaceAllocator выбирает место хранения для заказа заданного объема и веса.
+// Если подходящего места нет, возвращает nil без ошибки.
+type StoragePlaceAllocator interface {
+	Allocate(places []*StoragePlace, volume int, weight int) (*StoragePlace, error)
+	Strategy() AllocationStrategy
+}
+
+type AllocationStrategy string
+
+const (
+	AllocationFirstFit AllocationStrategy = "first-fit"
+	AllocationBestFit  AllocationStrategy = "best-fit"
+)
+
+// NewStoragePlaceAllocator создает распределитель по названию стратегии.
+// Пустое название соответствует стратегии по умолчанию - наименьшему подходящему месту
+func NewStoragePlaceAllocator(strategy AllocationStrategy) (StoragePlaceAllocator, error) {
+	switch strategy {
+	case "", AllocationBestFit:
+		return NewBestFitAllocator(), nil
+	case AllocationFirstFit:
+		return NewFirstFitAllocator(), nil
+	default:
+		return nil, errs.NewValueIsInvalidError("allocation strategy")
+	}
+}
+
+var (
+	_ StoragePlaceAllocator = &firstFitAllocator{}
+	_ StoragePlaceAllocator = &bestFitAllocator{}
+)
+
+// firstFitAllocator выбирает первое свободное место достаточного объема
+type firstFitAllocator struct{}
+
+func NewFirstFitAllocator() StoragePlaceAllocator {
+	return &firstFitAllocator{}
+}
+
+func (a *firstFitAllocator) Strategy() AllocationStrategy {
+	return AllocationFirstFit
+}
+
+func (a *firstFitAllocator) Allocate(places []*StoragePlace, volume int, weight int) (*StoragePlace, error) {
+	if volume <= 0 {
+		return nil, errs.NewValueIsInvalidError("volume")
+	}
+
+	for _, place := range places {
+		can, err := place.CanStore(volume, weight)
+		if err != nil {
+			return nil, err
+		}
+
+		if can {
+			return place, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// bestFitAllocator выбирает свободное место с наименьшим достаточным объемом,
+// оставляя большие места для больших заказов
+type bestFitAllocator struct{}
+
+func NewBestFitAllocator() StoragePlaceAllocator {
+	return &bestFitAllocator{}
+}
+
+func (a *bestFitAllocator) Strategy() AllocationStrategy {
+	return AllocationBestFit
+}
+
+func (a *bestFitAllocator) Allocate(places []*StoragePlace, volume int, weight int) (*StoragePlace, error) {
+	if volume <= 0 {
+		return nil, errs.NewValueIsInvalidError("volume")
+	}
+
+	var best *StoragePlace
+	for _, place := range places {
+		can, err := place.CanStore(volume, weight)
+		if err != nil {
+			return nil, err
+		}
+
+		if !can {
+			continue
+		}
+
+		if best == nil || place.TotalVolume() < best.TotalVolume() {
+			best = place
+		}
+	}
+
+	return best, nil
+}
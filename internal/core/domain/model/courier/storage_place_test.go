@@ -63,7 +63,7 @@ func TestStoragePlace_New(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := courier.NewStoragePlace(tt.args.name, tt.args.totalVolume)
+			got, err := courier.NewStoragePlace(tt.args.name, tt.args.totalVolume, 0)
 			if tt.wantErr != nil {
 				assert.Equal(t, tt.wantErr.Error(), err.Error())
 				assert.Nil(t, got)
@@ -81,10 +81,10 @@ func TestStoragePlace_New(t *testing.T) {
 }
 
 func TestStoragePlace_Equals(t *testing.T) {
-	s1, err := courier.NewStoragePlace("Backpack", 10)
+	s1, err := courier.NewStoragePlace("Backpack", 10, 0)
 	require.Nil(t, err)
 
-	s2, err := courier.NewStoragePlace("Backpack", 10)
+	s2, err := courier.NewStoragePlace("Backpack", 10, 0)
 	require.Nil(t, err)
 
 	assert.False(t, s1.Equals(s2))
@@ -132,10 +132,10 @@ func TestStoragePlace_Store(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			storage, err := courier.NewStoragePlace("Backpack", 15)
+			storage, err := courier.NewStoragePlace("Backpack", 15, 0)
 			require.Nil(t, err)
 
-			got := storage.Store(tt.args.orderID, tt.args.volume)
+			got := storage.Store(tt.args.orderID, tt.args.volume, 0)
 			if tt.wantErr != nil {
 				assert.Equal(t, tt.wantErr.Error(), got.Error())
 				fmt.Println(storage.OrderID())
@@ -151,13 +151,13 @@ func TestStoragePlace_Store(t *testing.T) {
 }
 
 func TestStoragePlace_Store_IsOccupied(t *testing.T) {
-	storage, err := courier.NewStoragePlace("Backpack", 15)
+	storage, err := courier.NewStoragePlace("Backpack", 15, 0)
 	require.Nil(t, err)
 
-	got := storage.Store(uuid.New(), 10)
+	got := storage.Store(uuid.New(), 10, 0)
 	assert.Nil(t, got)
 
-	got = storage.Store(uuid.New(), 10)
+	got = storage.Store(uuid.New(), 10, 0)
 	assert.Equal(t, courier.ErrCanNotStoreOrder.Error(), got.Error())
 }
 
@@ -203,10 +203,10 @@ func TestStoragePlace_CanStore(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s, err := courier.NewStoragePlace(tt.args.name, tt.args.totalVolume)
+			s, err := courier.NewStoragePlace(tt.args.name, tt.args.totalVolume, 0)
 			require.Nil(t, err)
 
-			got, err := s.CanStore(tt.volume)
+			got, err := s.CanStore(tt.volume, 0)
 			if tt.wantErr != nil {
 				assert.Equal(t, tt.wantErr.Error(), err.Error())
 				assert.False(t, got)
@@ -219,11 +219,48 @@ func TestStoragePlace_CanStore(t *testing.T) {
 	}
 }
 
+func TestStoragePlace_CanStore_Weight(t *testing.T) {
+	limited, err := courier.NewStoragePlace("Bag", 10, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, 5000, limited.MaxWeight())
+
+	unlimited, err := courier.NewStoragePlace("Trunk", 10, 0)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		place  *courier.StoragePlace
+		weight int
+		want   bool
+	}{
+		{name: "Unknown weight", place: limited, weight: 0, want: true},
+		{name: "Weight at limit", place: limited, weight: 5000, want: true},
+		{name: "Weight over limit", place: limited, weight: 5001, want: false},
+		{name: "No limit", place: unlimited, weight: 100000, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.place.CanStore(5, tt.weight)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err = limited.CanStore(5, -1)
+	assert.Equal(t, errs.NewValueIsInvalidError("weight").Error(), err.Error())
+
+	err = limited.Store(uuid.New(), 5, 6000)
+	assert.ErrorIs(t, err, courier.ErrCanNotStoreOrder)
+
+	_, err = courier.NewStoragePlace("Bag", 10, -1)
+	assert.Equal(t, errs.NewValueIsInvalidError("maxWeight").Error(), err.Error())
+}
+
 func TestStoragePlace_Clear_Occupied(t *testing.T) {
-	s, err := courier.NewStoragePlace("Backpack", 15)
+	s, err := courier.NewStoragePlace("Backpack", 15, 0)
 	require.Nil(t, err)
 
-	err = s.Store(uuid.New(), 10)
+	err = s.Store(uuid.New(), 10, 0)
 	require.Nil(t, err)
 
 	err = s.Clear()
@@ -233,7 +270,7 @@ func TestStoragePlace_Clear_Occupied(t *testing.T) {
 }
 
 func TestStoragePlace_Clear_Empty(t *testing.T) {
-	s, err := courier.NewStoragePlace("Backpack", 15)
+	s, err := courier.NewStoragePlace("Backpack", 15, 0)
 	require.Nil(t, err)
 
 	err = s.Clear()
@@ -242,3 +279,96 @@ func TestStoragePlace_Clear_Empty(t *testing.T) {
 
 	require.Nil(t, s.OrderID())
 }
+
+func TestStoragePlaceAllocator_Allocate(t *testing.T) {
+	newPlace := func(name string, volume int) *courier.StoragePlace {
+		sp, err := courier.NewStoragePlace(name, volume, 0)
+		require.NoError(t, err)
+		return sp
+	}
+	occupiedPlace := func(name string, volume int) *courier.StoragePlace {
+		sp := newPlace(name, volume)
+		require.NoError(t, sp.Store(uuid.New(), 1, 0))
+		return sp
+	}
+
+	tests := []struct {
+		name          string
+		places        []*courier.StoragePlace
+		volume        int
+		wantFirstFit  string
+		wantBestFit   string
+		wantNoneFound bool
+	}{
+		{
+			name:         "Small pocket before large trunk",
+			places:       []*courier.StoragePlace{newPlace("Pocket", 2), newPlace("Trunk", 20)},
+			volume:       5,
+			wantFirstFit: "Trunk",
+			wantBestFit:  "Trunk",
+		},
+		{
+			name:         "Large trunk before fitting bag",
+			places:       []*courier.StoragePlace{newPlace("Trunk", 20), newPlace("Bag", 10), newPlace("Pocket", 2)},
+			volume:       5,
+			wantFirstFit: "Trunk",
+			wantBestFit:  "Bag",
+		},
+		{
+			name:         "Smallest place is occupied",
+			places:       []*courier.StoragePlace{newPlace("Trunk", 20), occupiedPlace("Bag", 10), newPlace("Box", 15)},
+			volume:       5,
+			wantFirstFit: "Trunk",
+			wantBestFit:  "Box",
+		},
+		{
+			name:          "All places are too small",
+			places:        []*courier.StoragePlace{newPlace("Pocket", 2), newPlace("Bag", 4)},
+			volume:        5,
+			wantNoneFound: true,
+		},
+		{
+			name:          "Suitable place is occupied",
+			places:        []*courier.StoragePlace{newPlace("Pocket", 2), occupiedPlace("Trunk", 20)},
+			volume:        5,
+			wantNoneFound: true,
+		},
+		{
+			name:          "No places",
+			volume:        5,
+			wantNoneFound: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			firstFit, err := courier.NewFirstFitAllocator().Allocate(tt.places, tt.volume, 0)
+			require.NoError(t, err)
+
+			bestFit, err := courier.NewBestFitAllocator().Allocate(tt.places, tt.volume, 0)
+			require.NoError(t, err)
+
+			if tt.wantNoneFound {
+				assert.Nil(t, firstFit)
+				assert.Nil(t, bestFit)
+
+				return
+			}
+
+			require.NotNil(t, firstFit)
+			require.NotNil(t, bestFit)
+			assert.Equal(t, tt.wantFirstFit, firstFit.Name())
+			assert.Equal(t, tt.wantBestFit, bestFit.Name())
+		})
+	}
+}
+
+func TestStoragePlaceAllocator_InvalidVolume(t *testing.T) {
+	sp, err := courier.NewStoragePlace("Bag", 10, 0)
+	require.NoError(t, err)
+
+	_, err = courier.NewFirstFitAllocator().Allocate([]*courier.StoragePlace{sp}, 0, 0)
+	assert.Equal(t, errs.NewValueIsInvalidError("volume").Error(), err.Error())
+
+	_, err = courier.NewBestFitAllocator().Allocate([]*courier.StoragePlace{sp}, 0, 0)
+	assert.Equal(t, errs.NewValueIsInvalidError("volume").Error(), err.Error())
+}
@@ -0,0 +1,99 @@
+package courier
+
+import "delivery/internal/pkg/errs"
+
+const (
+	VehicleFoot    VehicleType = "foot"
+	VehicleBicycle VehicleType = "bicycle"
+	VehicleScooter VehicleType = "scooter"
+	VehicleCar     VehicleType = "car"
+)
+
+// VehicleType - вид транспорта курьера. Определяет скорость и места хранения по умолчанию,
+// а также ограничения на заказы, которые курьер может везти
+type VehicleType string
+
+type storagePlaceProfile struct {
+	name      string
+	volume    int
+	maxWeight int
+}
+
+type vehicleProfile struct {
+	speed         int
+	storagePlaces []storagePlaceProfile
+	// maxOrderVolume - максимальный объем одного заказа, 0 - без ограничений.
+	// Действует и для мест хранения, добавленных курьеру сверх положенных транспорту
+	maxOrderVolume int
+	// maxOrderWeight - максимальный вес одного заказа в граммах, 0 - без ограничений
+	maxOrderWeight int
+}
+
+var vehicleProfiles = map[VehicleType]vehicleProfile{
+	VehicleFoot: {
+		speed:          1,
+		storagePlaces:  []storagePlaceProfile{{name: "Bag", volume: 10, maxWeight: 5000}},
+		maxOrderVolume: 5,
+		maxOrderWeight: 5000,
+	},
+	VehicleBicycle: {
+		speed: 2,
+		storagePlaces: []storagePlaceProfile{
+			{name: "Bag", volume: 10, maxWeight: 5000},
+			{name: "Basket", volume: 10, maxWeight: 8000},
+		},
+		maxOrderVolume: 15,
+		maxOrderWeight: 8000,
+	},
+	VehicleScooter: {
+		speed:          3,
+		storagePlaces:  []storagePlaceProfile{{name: "Box", volume: 20, maxWeight: 15000}},
+		maxOrderVolume: 20,
+		maxOrderWeight: 15000,
+	},
+	VehicleCar: {
+		speed:         4,
+		storagePlaces: []storagePlaceProfile{{name: "Trunk", volume: 50}, {name: "Back seat", volume: 30}},
+	},
+}
+
+func ParseVehicleType(value string) (VehicleType, error) {
+	v := VehicleType(value)
+	if !v.IsValid() {
+		return "", errs.NewValueIsInvalidError("vehicleType")
+	}
+
+	return v, nil
+}
+
+func (v VehicleType) IsValid() bool {
+	_, ok := vehicleProfiles[v]
+	return ok
+}
+
+// DefaultSpeed возвращает скорость курьера на этом транспорте
+func (v VehicleType) DefaultSpeed() int {
+	return vehicleProfiles[v].speed
+}
+
+// CanCarry проверяет, можно ли везти на этом транспорте заказ объемом volume и весом weight в граммах
+func (v VehicleType) CanCarry(volume int, weight int) bool {
+	profile, ok := vehicleProfiles[v]
+	if !ok {
+		return false
+	}
+
+	if profile.maxOrderVolume > 0 && volume > profile.maxOrderVolume {
+		return false
+	}
+
+	return profile.maxOrderWeight == 0 || weight <= profile.maxOrderWeight
+}
+
+func (v VehicleType) Equals(other VehicleType) bool {
+	return v == other
+}
+
+func (v VehicleType) String() string {
+	return string(v)
+}
@@ -0,0 +1,142 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// StepDuration - длительность шага, за который курьер проходит speed клеток по сетке.
+// Совпадает с периодом перемещения курьеров
+const StepDuration = time.Second
+
+// DistanceMetric определяет, как измеряется расстояние между точками карты и как по ней движется курьер
+type DistanceMetric interface {
+	// Distance возвращает расстояние между точками: в клетках или в километрах
+	Distance(from, to Location) float64
+	// Advance возвращает точку, в которой окажется движущийся из from в to, пройдя distance
+	Advance(from, to Location, distance float64) (Location, error)
+	// TravelDistance возвращает расстояние, которое проходит курьер со скоростью speed за elapsed
+	TravelDistance(speed int, elapsed time.Duration) float64
+	// TravelTime возвращает время, за которое курьер со скоростью speed пройдет distance
+	TravelTime(speed int, distance float64) time.Duration
+}
+
+type metricHolder struct {
+	metric DistanceMetric
+}
+
+var currentMetric atomic.Pointer[metricHolder]
+
+func init() {
+	currentMetric.Store(&metricHolder{metric: NewManhattanMetric()})
+}
+
+// SetDistanceMetric задает метрику расстояния. Вызывается при старте приложения
+func SetDistanceMetric(metric DistanceMetric) error {
+	if metric == nil {
+		return errs.NewValueIsRequiredError("metric")
+	}
+
+	currentMetric.Store(&metricHolder{metric: metric})
+
+	return nil
+}
+
+// CurrentDistanceMetric возвращает текущую метрику расстояния, по умолчанию - манхэттенскую
+func CurrentDistanceMetric() DistanceMetric {
+	return currentMetric.Load().metric
+}
+
+var _ DistanceMetric = manhattanMetric{}
+
+// manhattanMetric - расстояние в клетках карты, скорость - клеток за шаг вне зависимости от времени
+type manhattanMetric struct{}
+
+func NewManhattanMetric() DistanceMetric {
+	return manhattanMetric{}
+}
+
+func (m manhattanMetric) Distance(from, to Location) float64 {
+	return float64(from.DistanceTo(to))
+}
+
+// Advance сначала двигает по оси X, затем по оси Y
+func (m manhattanMetric) Advance(from, to Location, distance float64) (Location, error) {
+	dx := float64(to.X() - from.X())
+	dy := float64(to.Y() - from.Y())
+	remainingRange := math.Floor(distance)
+
+	if math.Abs(dx) > remainingRange {
+		dx = math.Copysign(remainingRange, dx)
+	}
+	remainingRange -= math.Abs(dx)
+
+	if math.Abs(dy) > remainingRange {
+		dy = math.Copysign(remainingRange, dy)
+	}
+
+	return NewLocation(from.X()+int(dx), from.Y()+int(dy))
+}
+
+func (m manhattanMetric) TravelDistance(speed int, _ time.Duration) float64 {
+	return float64(speed)
+}
+
+func (m manhattanMetric) TravelTime(speed int, distance float64) time.Duration {
+	return StepsTravelTime(speed, distance)
+}
+
+var _ DistanceMetric = haversineMetric{}
+
+// haversineMetric - расстояние в километрах по поверхности Земли, скорость - в км/ч.
+// Клетки карты переводятся в географические координаты проекцией
+type haversineMetric struct {
+	projection GeoProjection
+}
+
+func NewHaversineMetric(projection GeoProjection) (DistanceMetric, error) {
+	if !projection.IsValid() {
+		return nil, errs.NewValueIsInvalidError("projection")
+	}
+
+	return haversineMetric{projection: projection}, nil
+}
+
+func (m haversineMetric) Distance(from, to Location) float64 {
+	return m.projection.ToGeoPoint(from).DistanceTo(m.projection.ToGeoPoint(to))
+}
+
+// Advance двигает по дуге большого круга и округляет результат до ближайшей клетки.
+// Если пройденного пути не хватает, чтобы покинуть клетку, курьер остается на месте
+func (m haversineMetric) Advance(from, to Location, distance float64) (Location, error) {
+	if from.Equals(to) || distance <= 0 {
+		return from, nil
+	}
+
+	target := m.projection.ToGeoPoint(to)
+	return m.projection.ToLocation(m.projection.ToGeoPoint(from).MoveTowards(target, distance))
+}
+
+func (m haversineMetric) TravelDistance(speed int, elapsed time.Duration) float64 {
+	return float64(speed) * elapsed.Hours()
+}
+
+func (m haversineMetric) TravelTime(speed int, distance float64) time.Duration {
+	if speed <= 0 || math.IsInf(distance, 1) {
+		return math.MaxInt64
+	}
+
+	return time.Duration(distance / float64(speed) * float64(time.Hour))
+}
+
+// StepsTravelTime возвращает время в пути для метрик, где курьер за шаг проходит speed клеток.
+// Неполный шаг считается целым
+func StepsTravelTime(speed int, distance float64) time.Duration {
+	if speed <= 0 || math.IsInf(distance, 1) {
+		return math.MaxInt64
+	}
+
+	return time.Duration(math.Ceil(distance/float64(speed))) * StepDuration
+}
@@ -0,0 +1,121 @@
+package kernel_test
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManhattanMetric(t *testing.T) {
+	metric := kernel.NewManhattanMetric()
+
+	assert.Equal(t, 5.0, metric.Distance(createValidLocation(4, 9), createValidLocation(2, 6)))
+	assert.Equal(t, 3.0, metric.TravelDistance(3, time.Hour))
+	assert.Equal(t, 3*kernel.StepDuration, metric.TravelTime(2, 5))
+	assert.Equal(t, time.Duration(0), metric.TravelTime(2, 0))
+
+	next, err := metric.Advance(createValidLocation(1, 1), createValidLocation(3, 5), 4)
+	require.NoError(t, err)
+	assert.Equal(t, createValidLocation(3, 3), next)
+
+	next, err = metric.Advance(createValidLocation(1, 1), createValidLocation(3, 5), 100)
+	require.NoError(t, err)
+	assert.Equal(t, createValidLocation(3, 5), next)
+}
+
+func TestGeoProjection_RoundTrip(t *testing.T) {
+	projection := createValidProjection(t, 0.5)
+
+	for _, l := range []kernel.Location{kernel.MinLocation(), createValidLocation(3, 7), kernel.MaxLocation()} {
+		got, err := projection.ToLocation(projection.ToGeoPoint(l))
+		require.NoError(t, err)
+		assert.Equal(t, l, got)
+	}
+
+	assert.InDelta(t, 0.5,
+		projection.ToGeoPoint(createValidLocation(1, 1)).DistanceTo(projection.ToGeoPoint(createValidLocation(1, 2))), 1e-6)
+	assert.InDelta(t, 0.5,
+		projection.ToGeoPoint(createValidLocation(1, 1)).DistanceTo(projection.ToGeoPoint(createValidLocation(2, 1))), 1e-3)
+}
+
+func TestNewGeoProjection_Invalid(t *testing.T) {
+	_, err := kernel.NewGeoProjection(kernel.GeoPoint{}, 1, kernel.DefaultGrid())
+	assert.Equal(t, errs.NewValueIsInvalidError("origin").Error(), err.Error())
+
+	_, err = kernel.NewGeoProjection(createValidGeoPoint(55, 37), 0, kernel.DefaultGrid())
+	assert.Equal(t, errs.NewValueIsInvalidError("cellSizeKm").Error(), err.Error())
+
+	_, err = kernel.NewGeoProjection(createValidGeoPoint(55, 37), 1, kernel.Grid{})
+	assert.Equal(t, errs.NewValueIsInvalidError("grid").Error(), err.Error())
+}
+
+func TestGeoProjection_UsesOwnGrid(t *testing.T) {
+	grid, err := kernel.NewGrid(0, 0, 100, 50)
+	require.NoError(t, err)
+
+	origin := createValidGeoPoint(55.751244, 37.618423)
+	projection, err := kernel.NewGeoProjection(origin, 0.5, grid)
+	require.NoError(t, err)
+
+	assert.Equal(t, origin, projection.ToGeoPoint(grid.MinLocation()))
+
+	far, err := grid.NewLocation(100, 50)
+	require.NoError(t, err)
+
+	got, err := projection.ToLocation(projection.ToGeoPoint(far))
+	require.NoError(t, err)
+	assert.Equal(t, far, got)
+}
+
+func TestHaversineMetric(t *testing.T) {
+	metric, err := kernel.NewHaversineMetric(createValidProjection(t, 0.5))
+	require.NoError(t, err)
+
+	assert.InDelta(t, 1.5, metric.Distance(createValidLocation(1, 1), createValidLocation(1, 4)), 1e-6)
+	assert.InDelta(t, 10.0, metric.TravelDistance(20, 30*time.Minute), 1e-9)
+	assert.Equal(t, 30*time.Minute, metric.TravelTime(20, 10))
+
+	next, err := metric.Advance(createValidLocation(1, 1), createValidLocation(1, 9), 1)
+	require.NoError(t, err)
+	assert.Equal(t, createValidLocation(1, 3), next)
+
+	next, err = metric.Advance(createValidLocation(1, 1), createValidLocation(1, 9), 100)
+	require.NoError(t, err)
+	assert.Equal(t, createValidLocation(1, 9), next)
+
+	// шага меньше половины клетки не хватает, чтобы покинуть клетку
+	next, err = metric.Advance(createValidLocation(1, 1), createValidLocation(1, 9), 0.1)
+	require.NoError(t, err)
+	assert.Equal(t, createValidLocation(1, 1), next)
+
+	_, err = kernel.NewHaversineMetric(kernel.GeoProjection{})
+	assert.Error(t, err)
+}
+
+func TestSetDistanceMetric(t *testing.T) {
+	previous := kernel.CurrentDistanceMetric()
+	t.Cleanup(func() {
+		require.NoError(t, kernel.SetDistanceMetric(previous))
+	})
+
+	err := kernel.SetDistanceMetric(nil)
+	assert.Equal(t, errs.NewValueIsRequiredError("metric").Error(), err.Error())
+
+	metric, err := kernel.NewHaversineMetric(createValidProjection(t, 1))
+	require.NoError(t, err)
+	require.NoError(t, kernel.SetDistanceMetric(metric))
+	assert.Equal(t, metric, kernel.CurrentDistanceMetric())
+}
+
+func createValidProjection(t *testing.T, cellSizeKm float64) kernel.GeoProjection {
+	t.Helper()
+
+	projection, err := kernel.NewGeoProjection(createValidGeoPoint(55.751244, 37.618423), cellSizeKm, kernel.DefaultGrid())
+	require.NoError(t, err)
+
+	return projection
+}
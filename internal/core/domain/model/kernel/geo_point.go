@@ -0,0 +1,108 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"fmt"
+	"math"
+)
+
+// EarthRadiusKm - средний радиус Земли
+const EarthRadiusKm = 6371.0
+
+// GeoPoint - географическая координата в градусах
+type GeoPoint struct {
+	lat     float64
+	lon     float64
+	isValid bool
+}
+
+func NewGeoPoint(lat, lon float64) (GeoPoint, error) {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return GeoPoint{}, errs.NewValueIsInvalidError("lat")
+	}
+
+	if math.IsNaN(lon) || lon < -180 || lon > 180 {
+		return GeoPoint{}, errs.NewValueIsInvalidError("lon")
+	}
+
+	return GeoPoint{
+		lat:     lat,
+		lon:     lon,
+		isValid: true,
+	}, nil
+}
+
+func (p GeoPoint) Lat() float64 {
+	return p.lat
+}
+
+func (p GeoPoint) Lon() float64 {
+	return p.lon
+}
+
+func (p GeoPoint) Equals(other GeoPoint) bool {
+	return p == other
+}
+
+func (p GeoPoint) IsValid() bool {
+	return p.isValid
+}
+
+func (p GeoPoint) String() string {
+	return fmt.Sprintf("(%.6f,%.6f)", p.lat, p.lon)
+}
+
+// DistanceTo возвращает расстояние по дуге большого круга в километрах (формула гаверсинусов)
+func (p GeoPoint) DistanceTo(other GeoPoint) float64 {
+	return EarthRadiusKm * p.angleTo(other)
+}
+
+// MoveTowards возвращает точку на дуге большого круга от p до target,
+// удаленную от p на distanceKm. Если target ближе, возвращается target
+func (p GeoPoint) MoveTowards(target GeoPoint, distanceKm float64) GeoPoint {
+	angle := p.angleTo(target)
+	if distanceKm <= 0 || angle == 0 {
+		return p
+	}
+
+	fraction := distanceKm / (EarthRadiusKm * angle)
+	if fraction >= 1 {
+		return target
+	}
+
+	lat1, lon1 := toRadians(p.lat), toRadians(p.lon)
+	lat2, lon2 := toRadians(target.lat), toRadians(target.lon)
+
+	a := math.Sin((1-fraction)*angle) / math.Sin(angle)
+	b := math.Sin(fraction*angle) / math.Sin(angle)
+
+	x := a*math.Cos(lat1)*math.Cos(lon1) + b*math.Cos(lat2)*math.Cos(lon2)
+	y := a*math.Cos(lat1)*math.Sin(lon1) + b*math.Cos(lat2)*math.Sin(lon2)
+	z := a*math.Sin(lat1) + b*math.Sin(lat2)
+
+	return GeoPoint{
+		lat:     toDegrees(math.Atan2(z, math.Sqrt(x*x+y*y))),
+		lon:     toDegrees(math.Atan2(y, x)),
+		isValid: true,
+	}
+}
+
+// angleTo возвращает центральный угол между точками в радианах
+func (p GeoPoint) angleTo(other GeoPoint) float64 {
+	lat1, lat2 := toRadians(p.lat), toRadians(other.lat)
+	dLat := lat2 - lat1
+	dLon := toRadians(other.lon - p.lon)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * math.Asin(math.Sqrt(min(h, 1)))
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
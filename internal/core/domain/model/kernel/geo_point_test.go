@@ -0,0 +1,122 @@
+package kernel_test
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGeoPoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		lat     float64
+		lon     float64
+		wantErr error
+	}{
+		{
+			name: "Valid point",
+			lat:  55.7558,
+			lon:  37.6173,
+		},
+		{
+			name: "Bounds",
+			lat:  -90,
+			lon:  180,
+		},
+		{
+			name:    "Latitude out of range",
+			lat:     90.1,
+			lon:     0,
+			wantErr: errs.NewValueIsInvalidError("lat"),
+		},
+		{
+			name:    "Longitude out of range",
+			lat:     0,
+			lon:     -180.1,
+			wantErr: errs.NewValueIsInvalidError("lon"),
+		},
+		{
+			name:    "NaN latitude",
+			lat:     math.NaN(),
+			lon:     0,
+			wantErr: errs.NewValueIsInvalidError("lat"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := kernel.NewGeoPoint(tt.lat, tt.lon)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+				assert.False(t, p.IsValid())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.lat, p.Lat())
+			assert.Equal(t, tt.lon, p.Lon())
+			assert.True(t, p.IsValid())
+		})
+	}
+}
+
+func TestGeoPoint_DistanceTo(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  kernel.GeoPoint
+		second kernel.GeoPoint
+		want   float64
+	}{
+		{
+			name:   "Moscow - Saint Petersburg",
+			first:  createValidGeoPoint(55.7558, 37.6173),
+			second: createValidGeoPoint(59.9343, 30.3351),
+			want:   633.02,
+		},
+		{
+			name:   "One degree of longitude on equator",
+			first:  createValidGeoPoint(0, 0),
+			second: createValidGeoPoint(0, 1),
+			want:   111.195,
+		},
+		{
+			name:   "Same point",
+			first:  createValidGeoPoint(10, 10),
+			second: createValidGeoPoint(10, 10),
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.first.DistanceTo(tt.second), 0.01)
+			assert.InDelta(t, tt.want, tt.second.DistanceTo(tt.first), 0.01)
+		})
+	}
+}
+
+func TestGeoPoint_MoveTowards(t *testing.T) {
+	from := createValidGeoPoint(55.7558, 37.6173)
+	to := createValidGeoPoint(59.9343, 30.3351)
+	total := from.DistanceTo(to)
+
+	middle := from.MoveTowards(to, total/2)
+	assert.InDelta(t, total/2, from.DistanceTo(middle), 1e-6)
+	assert.InDelta(t, total/2, middle.DistanceTo(to), 1e-6)
+
+	assert.Equal(t, to, from.MoveTowards(to, total+1))
+	assert.Equal(t, from, from.MoveTowards(to, 0))
+	assert.Equal(t, from, from.MoveTowards(from, 10))
+}
+
+func createValidGeoPoint(lat, lon float64) kernel.GeoPoint {
+	p, err := kernel.NewGeoPoint(lat, lon)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
@@ -0,0 +1,87 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"math"
+)
+
+const kmPerDegree = EarthRadiusKm * math.Pi / 180
+
+// GeoProjection переводит клетки карты в географические координаты и обратно.
+// Клетка с минимальными координатами соответствует origin, ось X направлена на восток,
+// ось Y - на север, сторона клетки равна cellSizeKm
+type GeoProjection struct {
+	origin     GeoPoint
+	cellSizeKm float64
+	grid       Grid
+}
+
+func NewGeoProjection(origin GeoPoint, cellSizeKm float64, grid Grid) (GeoProjection, error) {
+	if !origin.IsValid() {
+		return GeoProjection{}, errs.NewValueIsInvalidError("origin")
+	}
+
+	// у полюсов долгота вырождается
+	if math.Abs(origin.Lat()) >= 89 {
+		return GeoProjection{}, errs.NewValueIsInvalidError("origin")
+	}
+
+	if math.IsNaN(cellSizeKm) || cellSizeKm <= 0 {
+		return GeoProjection{}, errs.NewValueIsInvalidError("cellSizeKm")
+	}
+
+	if !grid.isValid() {
+		return GeoProjection{}, errs.NewValueIsInvalidError("grid")
+	}
+
+	return GeoProjection{
+		origin:     origin,
+		cellSizeKm: cellSizeKm,
+		grid:       grid,
+	}, nil
+}
+
+func (p GeoProjection) Origin() GeoPoint {
+	return p.origin
+}
+
+func (p GeoProjection) CellSizeKm() float64 {
+	return p.cellSizeKm
+}
+
+func (p GeoProjection) IsValid() bool {
+	return p.origin.IsValid() && p.cellSizeKm > 0
+}
+
+func (p GeoProjection) ToGeoPoint(l Location) GeoPoint {
+	lat := p.origin.lat + float64(l.Y()-p.grid.minY)*p.cellSizeKm/kmPerDegree
+	lon := p.origin.lon + float64(l.X()-p.grid.minX)*p.cellSizeKm/p.kmPerDegreeLon()
+
+	return GeoPoint{
+		lat:     lat,
+		lon:     lon,
+		isValid: true,
+	}
+}
+
+// ToLocation возвращает ближайшую к точке клетку карты. Точки за пределами карты
+// прижимаются к ее границе
+func (p GeoProjection) ToLocation(point GeoPoint) (Location, error) {
+	if !point.IsValid() {
+		return Location{}, errs.NewValueIsInvalidError("point")
+	}
+
+	grid := p.grid
+
+	x := grid.minX + int(math.Round((point.lon-p.origin.lon)*p.kmPerDegreeLon()/p.cellSizeKm))
+	y := grid.minY + int(math.Round((point.lat-p.origin.lat)*kmPerDegree/p.cellSizeKm))
+
+	return grid.NewLocation(
+		min(max(x, grid.minX), grid.maxX),
+		min(max(y, grid.minY), grid.maxY),
+	)
+}
+
+func (p GeoProjection) kmPerDegreeLon() float64 {
+	return kmPerDegree * math.Cos(toRadians(p.origin.lat))
+}
@@ -0,0 +1,110 @@
+package kernel
+
+import (
+	"delivery/internal/pkg/errs"
+	"sync/atomic"
+)
+
+// Grid - границы карты, в которых допустимы координаты Location
+type Grid struct {
+	minX int
+	minY int
+	maxX int
+	maxY int
+}
+
+const (
+	defaultMinCoordinate = 1
+	defaultMaxCoordinate = 10
+)
+
+var currentGrid atomic.Pointer[Grid]
+
+func init() {
+	grid := DefaultGrid()
+	currentGrid.Store(&grid)
+}
+
+func NewGrid(minX, minY, maxX, maxY int) (Grid, error) {
+	if minX < 0 {
+		return Grid{}, errs.NewValueIsInvalidError("minX")
+	}
+
+	if minY < 0 {
+		return Grid{}, errs.NewValueIsInvalidError("minY")
+	}
+
+	if maxX <= minX {
+		return Grid{}, errs.NewValueIsInvalidError("maxX")
+	}
+
+	if maxY <= minY {
+		return Grid{}, errs.NewValueIsInvalidError("maxY")
+	}
+
+	return Grid{
+		minX: minX,
+		minY: minY,
+		maxX: maxX,
+		maxY: maxY,
+	}, nil
+}
+
+// DefaultGrid возвращает карту 1..10 x 1..10, которая используется, пока не задана другая
+func DefaultGrid() Grid {
+	return Grid{
+		minX: defaultMinCoordinate,
+		minY: defaultMinCoordinate,
+		maxX: defaultMaxCoordinate,
+		maxY: defaultMaxCoordinate,
+	}
+}
+
+// SetGrid задает границы карты. Вызывается при старте приложения до создания первых Location,
+// ранее созданные Location не перепроверяются
+func SetGrid(grid Grid) error {
+	if !grid.isValid() {
+		return errs.NewValueIsInvalidError("grid")
+	}
+
+	currentGrid.Store(&grid)
+
+	return nil
+}
+
+// CurrentGrid возвращает текущие границы карты
+func CurrentGrid() Grid {
+	return *currentGrid.Load()
+}
+
+func (g Grid) MinX() int {
+	return g.minX
+}
+
+func (g Grid) MinY() int {
+	return g.minY
+}
+
+func (g Grid) MaxX() int {
+	return g.maxX
+}
+
+func (g Grid) MaxY() int {
+	return g.maxY
+}
+
+func (g Grid) Contains(x, y int) bool {
+	return g.containsX(x) && g.containsY(y)
+}
+
+func (g Grid) containsX(x int) bool {
+	return x >= g.minX && x <= g.maxX
+}
+
+func (g Grid) containsY(y int) bool {
+	return y >= g.minY && y <= g.maxY
+}
+
+func (g Grid) isValid() bool {
+	return g.minX >= 0 && g.minY >= 0 && g.maxX > g.minX && g.maxY > g.minY
+}
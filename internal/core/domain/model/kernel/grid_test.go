@@ -0,0 +1,75 @@
+package kernel_test
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGrid(t *testing.T) {
+	tests := []struct {
+		name                   string
+		minX, minY, maxX, maxY int
+		wantErr                error
+	}{
+		{
+			name: "Valid grid",
+			minX: 1, minY: 1, maxX: 10, maxY: 20,
+		},
+		{
+			name: "Zero based grid",
+			minX: 0, minY: 0, maxX: 1, maxY: 1,
+		},
+		{
+			name: "Negative min X",
+			minX: -1, minY: 1, maxX: 10, maxY: 10,
+			wantErr: errs.NewValueIsInvalidError("minX"),
+		},
+		{
+			name: "Negative min Y",
+			minX: 1, minY: -1, maxX: 10, maxY: 10,
+			wantErr: errs.NewValueIsInvalidError("minY"),
+		},
+		{
+			name: "Max X not greater than min X",
+			minX: 5, minY: 1, maxX: 5, maxY: 10,
+			wantErr: errs.NewValueIsInvalidError("maxX"),
+		},
+		{
+			name: "Max Y less than min Y",
+			minX: 1, minY: 10, maxX: 10, maxY: 1,
+			wantErr: errs.NewValueIsInvalidError("maxY"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := kernel.NewGrid(tt.minX, tt.minY, tt.maxX, tt.maxY)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+				assert.Equal(t, kernel.Grid{}, g)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.minX, g.MinX())
+			assert.Equal(t, tt.minY, g.MinY())
+			assert.Equal(t, tt.maxX, g.MaxX())
+			assert.Equal(t, tt.maxY, g.MaxY())
+			assert.True(t, g.Contains(tt.minX, tt.maxY))
+			assert.False(t, g.Contains(tt.maxX+1, tt.minY))
+		})
+	}
+}
+
+func TestSetGrid_InvalidGrid(t *testing.T) {
+	previous := kernel.CurrentGrid()
+
+	err := kernel.SetGrid(kernel.Grid{})
+
+	assert.Equal(t, errs.NewValueIsInvalidError("grid").Error(), err.Error())
+	assert.Equal(t, previous, kernel.CurrentGrid())
+}
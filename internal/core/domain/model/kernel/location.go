@@ -12,19 +12,18 @@ type Location struct {
 	isValid bool
 }
 
-const (
-	minXCoordinate = 1
-	maxXCoordinate = 10
-	minYCoordinate = 1
-	maxYCoordinate = 10
-)
-
+// NewLocation создает координату в границах текущей карты, см. SetGrid
 func NewLocation(x, y int) (Location, error) {
-	if x > maxXCoordinate || x < minXCoordinate {
+	return CurrentGrid().NewLocation(x, y)
+}
+
+// NewLocation создает координату в границах карты g
+func (g Grid) NewLocation(x, y int) (Location, error) {
+	if !g.containsX(x) {
 		return Location{}, errs.NewValueIsInvalidError("x")
 	}
 
-	if y > maxYCoordinate || y < minYCoordinate {
+	if !g.containsY(y) {
 		return Location{}, errs.NewValueIsInvalidError("y")
 	}
 
@@ -35,6 +34,16 @@ func NewLocation(x, y int) (Location, error) {
 	}, nil
 }
 
+// RestoreLocation восстанавливает сохраненную координату без проверки границ текущей карты:
+// соответствие сохраненных координат карте проверяется один раз при старте приложения
+func RestoreLocation(x, y int) Location {
+	return Location{
+		x:       x,
+		y:       y,
+		isValid: true,
+	}
+}
+
 func (l Location) X() int {
 	return l.x
 }
@@ -59,9 +68,13 @@ func abs(a int) int {
 }
 
 func RandomLocation() Location {
-	loc, err := NewLocation(
-		randomCoordinate(minXCoordinate, maxXCoordinate),
-		randomCoordinate(minYCoordinate, maxYCoordinate),
+	return CurrentGrid().RandomLocation()
+}
+
+func (g Grid) RandomLocation() Location {
+	loc, err := g.NewLocation(
+		randomCoordinate(g.minX, g.maxX),
+		randomCoordinate(g.minY, g.maxY),
 	)
 
 	if err != nil {
@@ -72,7 +85,11 @@ func RandomLocation() Location {
 }
 
 func MinLocation() Location {
-	loc, err := NewLocation(minXCoordinate, minYCoordinate)
+	return CurrentGrid().MinLocation()
+}
+
+func (g Grid) MinLocation() Location {
+	loc, err := g.NewLocation(g.minX, g.minY)
 	if err != nil {
 		panic(fmt.Errorf("incorrect min location: %w", err))
 	}
@@ -81,7 +98,11 @@ func MinLocation() Location {
 }
 
 func MaxLocation() Location {
-	loc, err := NewLocation(maxXCoordinate, maxYCoordinate)
+	return CurrentGrid().MaxLocation()
+}
+
+func (g Grid) MaxLocation() Location {
+	loc, err := g.NewLocation(g.maxX, g.maxY)
 	if err != nil {
 		panic(fmt.Errorf("incorrect max location: %w", err))
 	}
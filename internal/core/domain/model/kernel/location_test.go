@@ -3,6 +3,7 @@ package kernel_test
 import (
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/pkg/errs"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -190,12 +191,57 @@ func TestLocation_DistanceTo(t *testing.T) {
 }
 
 func TestRandomLocation_Range(t *testing.T) {
-	for i := 0; i < 100; i++ {
-		loc := kernel.RandomLocation()
-		assert.GreaterOrEqual(t, loc.X(), 1)
-		assert.LessOrEqual(t, loc.X(), 10)
-		assert.GreaterOrEqual(t, loc.Y(), 1)
-		assert.LessOrEqual(t, loc.Y(), 10)
+	for _, grid := range testGrids() {
+		t.Run(gridName(grid), func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				loc := grid.RandomLocation()
+				assert.GreaterOrEqual(t, loc.X(), grid.MinX())
+				assert.LessOrEqual(t, loc.X(), grid.MaxX())
+				assert.GreaterOrEqual(t, loc.Y(), grid.MinY())
+				assert.LessOrEqual(t, loc.Y(), grid.MaxY())
+			}
+		})
+	}
+}
+
+func TestNewLocation_Grids(t *testing.T) {
+	for _, grid := range testGrids() {
+		t.Run(gridName(grid), func(t *testing.T) {
+			_, err := grid.NewLocation(grid.MinX(), grid.MaxY())
+			assert.NoError(t, err)
+
+			_, err = grid.NewLocation(grid.MaxX(), grid.MinY())
+			assert.NoError(t, err)
+
+			_, err = grid.NewLocation(grid.MinX()-1, grid.MinY())
+			assert.Equal(t, errs.NewValueIsInvalidError("x").Error(), err.Error())
+
+			_, err = grid.NewLocation(grid.MaxX()+1, grid.MinY())
+			assert.Equal(t, errs.NewValueIsInvalidError("x").Error(), err.Error())
+
+			_, err = grid.NewLocation(grid.MinX(), grid.MinY()-1)
+			assert.Equal(t, errs.NewValueIsInvalidError("y").Error(), err.Error())
+
+			_, err = grid.NewLocation(grid.MinX(), grid.MaxY()+1)
+			assert.Equal(t, errs.NewValueIsInvalidError("y").Error(), err.Error())
+		})
+	}
+}
+
+func TestMinMaxLocation_Grids(t *testing.T) {
+	for _, grid := range testGrids() {
+		t.Run(gridName(grid), func(t *testing.T) {
+			minLocation := grid.MinLocation()
+			assert.Equal(t, grid.MinX(), minLocation.X())
+			assert.Equal(t, grid.MinY(), minLocation.Y())
+
+			maxLocation := grid.MaxLocation()
+			assert.Equal(t, grid.MaxX(), maxLocation.X())
+			assert.Equal(t, grid.MaxY(), maxLocation.Y())
+
+			want := grid.MaxX() - grid.MinX() + grid.MaxY() - grid.MinY()
+			assert.Equal(t, want, minLocation.DistanceTo(maxLocation))
+		})
 	}
 }
 
@@ -217,3 +263,25 @@ func createValidLocation(x, y int) kernel.Location {
 
 	return l
 }
+
+func testGrids() []kernel.Grid {
+	return []kernel.Grid{
+		kernel.DefaultGrid(),
+		createValidGrid(0, 0, 1, 1),
+		createValidGrid(1, 1, 100, 50),
+		createValidGrid(0, 0, 1_000_000, 1_000_000),
+	}
+}
+
+func createValidGrid(minX, minY, maxX, maxY int) kernel.Grid {
+	g, err := kernel.NewGrid(minX, minY, maxX, maxY)
+	if err != nil {
+		panic(err)
+	}
+
+	return g
+}
+
+func gridName(grid kernel.Grid) string {
+	return fmt.Sprintf("%dx%d", grid.MaxX()-grid.MinX()+1, grid.MaxY()-grid.MinY()+1)
+}
@@ -0,0 +1,56 @@
+package order
+
+import (
+	"delivery/internal/pkg/errs"
+	"time"
+)
+
+// DeliveryWindow - обещанный получателю интервал доставки. Нулевое значение означает, что интервал не обещан
+type DeliveryWindow struct {
+	from    time.Time
+	to      time.Time
+	isValid bool
+}
+
+func NewDeliveryWindow(from, to time.Time) (DeliveryWindow, error) {
+	// Окно хранится с точностью до микросекунд, как в базе,
+	// чтобы сохраненное окно совпадало с окном из повторного запроса
+	from = from.UTC().Truncate(time.Microsecond)
+	to = to.UTC().Truncate(time.Microsecond)
+
+	if from.IsZero() {
+		return DeliveryWindow{}, errs.NewValueIsInvalidError("from")
+	}
+
+	if !to.After(from) {
+		return DeliveryWindow{}, errs.NewValueIsInvalidError("to")
+	}
+
+	return DeliveryWindow{
+		from:    from,
+		to:      to,
+		isValid: true,
+	}, nil
+}
+
+func (w DeliveryWindow) From() time.Time {
+	return w.from
+}
+
+func (w DeliveryWindow) To() time.Time {
+	return w.to
+}
+
+func (w DeliveryWindow) IsValid() bool {
+	return w.isValid
+}
+
+// IsMissedBy проверяет, опоздает ли доставка, которая завершится в момент eta.
+// Ранняя доставка окно не нарушает: курьер может подождать получателя
+func (w DeliveryWindow) IsMissedBy(eta time.Time) bool {
+	return w.isValid && eta.After(w.to)
+}
+
+func (w DeliveryWindow) Equals(other DeliveryWindow) bool {
+	return w.isValid == other.isValid && w.from.Equal(other.from) && w.to.Equal(other.to)
+}
@@ -0,0 +1,105 @@
+package order_test
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeliveryWindow(t *testing.T) {
+	from := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	w, err := order.NewDeliveryWindow(from, from.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, w.IsValid())
+	assert.Equal(t, from, w.From())
+	assert.Equal(t, from.Add(time.Hour), w.To())
+
+	assert.False(t, w.IsMissedBy(from.Add(-time.Hour)), "ранняя доставка не нарушает интервал")
+	assert.False(t, w.IsMissedBy(from.Add(time.Hour)))
+	assert.True(t, w.IsMissedBy(from.Add(time.Hour+time.Second)))
+	assert.False(t, order.DeliveryWindow{}.IsMissedBy(from), "интервал не обещан")
+
+	_, err = order.NewDeliveryWindow(time.Time{}, from)
+	assert.Equal(t, errs.NewValueIsInvalidError("from").Error(), err.Error())
+
+	_, err = order.NewDeliveryWindow(from, from)
+	assert.Equal(t, errs.NewValueIsInvalidError("to").Error(), err.Error())
+}
+
+func TestParsePriority(t *testing.T) {
+	for _, p := range []order.Priority{order.PriorityLow, order.PriorityNormal, order.PriorityHigh} {
+		got, err := order.ParsePriority(p.String())
+		require.NoError(t, err)
+		assert.Equal(t, p, got)
+	}
+
+	_, err := order.ParsePriority("asap")
+	assert.Equal(t, errs.NewValueIsInvalidError("priority").Error(), err.Error())
+}
+
+func TestOrder_PrioritizeAndPromiseDeliveryWindow(t *testing.T) {
+	o, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, order.PriorityNormal, o.Priority())
+	assert.False(t, o.DeliveryWindow().IsValid())
+
+	now := time.Now()
+	w, err := order.NewDeliveryWindow(now, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	require.NoError(t, o.Prioritize(order.PriorityHigh))
+	require.NoError(t, o.PromiseDeliveryWindow(w))
+	assert.Equal(t, order.PriorityHigh, o.Priority())
+	assert.Equal(t, w, o.DeliveryWindow())
+
+	assert.Equal(t, errs.NewValueIsInvalidError("priority").Error(), o.Prioritize(0).Error())
+	assert.Equal(t, errs.NewValueIsInvalidError("window").Error(), o.PromiseDeliveryWindow(order.DeliveryWindow{}).Error())
+
+	require.NoError(t, o.Assign(uuid.New()))
+	assert.ErrorIs(t, o.Prioritize(order.PriorityLow), order.ErrInvalidOrderStatus)
+	assert.ErrorIs(t, o.PromiseDeliveryWindow(w), order.ErrInvalidOrderStatus)
+}
+
+func TestOrder_CheckDeliveryRisk(t *testing.T) {
+	o, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+
+	now := time.Now()
+	assert.False(t, o.CheckDeliveryRisk(now.Add(time.Hour)), "без интервала риска нет")
+
+	w, err := order.NewDeliveryWindow(now, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, o.PromiseDeliveryWindow(w))
+	courierID := uuid.New()
+	require.NoError(t, o.Assign(courierID))
+	o.ClearDomainEvents()
+
+	assert.False(t, o.CheckDeliveryRisk(now.Add(30*time.Minute)))
+	assert.False(t, o.IsAtRisk())
+	assert.Empty(t, o.GetDomainEvents())
+
+	eta := now.Add(2 * time.Hour)
+	assert.True(t, o.CheckDeliveryRisk(eta))
+	assert.True(t, o.IsAtRisk())
+
+	events := o.GetDomainEvents()
+	require.Len(t, events, 1)
+	atRisk, ok := events[0].(order.OrderDeliveryAtRiskDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, "OrderDeliveryAtRiskDomainEvent", atRisk.GetName())
+	assert.Equal(t, o.ID(), atRisk.OrderID)
+	assert.Equal(t, courierID, atRisk.CourierID)
+	assert.Equal(t, w.To(), atRisk.WindowTo)
+	assert.True(t, eta.Equal(atRisk.EstimatedDeliveryAt))
+
+	// событие поднимается один раз
+	assert.False(t, o.CheckDeliveryRisk(now.Add(3*time.Hour)))
+	assert.Len(t, o.GetDomainEvents(), 1)
+}
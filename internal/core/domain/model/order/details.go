@@ -0,0 +1,140 @@
+package order
+
+import (
+	"delivery/internal/pkg/errs"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Dimensions - габариты заказа в сантиметрах. Нулевое значение означает, что габариты неизвестны
+type Dimensions struct {
+	length  int
+	width   int
+	height  int
+	isValid bool
+}
+
+func NewDimensions(length, width, height int) (Dimensions, error) {
+	if length <= 0 {
+		return Dimensions{}, errs.NewValueIsInvalidError("length")
+	}
+
+	if width <= 0 {
+		return Dimensions{}, errs.NewValueIsInvalidError("width")
+	}
+
+	if height <= 0 {
+		return Dimensions{}, errs.NewValueIsInvalidError("height")
+	}
+
+	return Dimensions{
+		length:  length,
+		width:   width,
+		height:  height,
+		isValid: true,
+	}, nil
+}
+
+func (d Dimensions) Length() int {
+	return d.length
+}
+
+func (d Dimensions) Width() int {
+	return d.width
+}
+
+func (d Dimensions) Height() int {
+	return d.height
+}
+
+func (d Dimensions) IsValid() bool {
+	return d.isValid
+}
+
+func (d Dimensions) Equals(other Dimensions) bool {
+	return d == other
+}
+
+// Item - позиция заказа из корзины
+type Item struct {
+	goodID   uuid.UUID
+	title    string
+	quantity int
+}
+
+func NewItem(goodID uuid.UUID, title string, quantity int) (Item, error) {
+	if goodID == uuid.Nil {
+		return Item{}, errs.NewValueIsInvalidError("goodID")
+	}
+
+	if strings.TrimSpace(title) == "" {
+		return Item{}, errs.NewValueIsInvalidError("title")
+	}
+
+	if quantity <= 0 {
+		return Item{}, errs.NewValueIsInvalidError("quantity")
+	}
+
+	return Item{
+		goodID:   goodID,
+		title:    title,
+		quantity: quantity,
+	}, nil
+}
+
+func (i Item) GoodID() uuid.UUID {
+	return i.goodID
+}
+
+func (i Item) Title() string {
+	return i.title
+}
+
+func (i Item) Quantity() int {
+	return i.quantity
+}
+
+// Details - вес, габариты и состав заказа. Все поля необязательные:
+// нулевой вес означает, что вес неизвестен
+type Details struct {
+	weight     int
+	dimensions Dimensions
+	items      []Item
+}
+
+// NewDetails создает описание заказа. Вес задается в граммах
+func NewDetails(weight int, dimensions Dimensions, items []Item) (Details, error) {
+	if weight < 0 {
+		return Details{}, errs.NewValueIsInvalidError("weight")
+	}
+
+	for _, item := range items {
+		if item.goodID == uuid.Nil {
+			return Details{}, errs.NewValueIsInvalidError("items")
+		}
+	}
+
+	return Details{
+		weight:     weight,
+		dimensions: dimensions,
+		items:      slices.Clone(items),
+	}, nil
+}
+
+func (d Details) Weight() int {
+	return d.weight
+}
+
+func (d Details) Dimensions() Dimensions {
+	return d.dimensions
+}
+
+func (d Details) Items() []Item {
+	return slices.Clone(d.items)
+}
+
+func (d Details) Equals(other Details) bool {
+	return d.weight == other.weight && d.dimensions.Equals(other.dimensions) && slices.Equal(d.items, other.items)
+}
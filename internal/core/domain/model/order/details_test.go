@@ -0,0 +1,115 @@
+package order_test
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDimensions(t *testing.T) {
+	tests := []struct {
+		name                  string
+		length, width, height int
+		wantErr               error
+	}{
+		{name: "Valid", length: 30, width: 20, height: 10},
+		{name: "Zero length", length: 0, width: 20, height: 10, wantErr: errs.NewValueIsInvalidError("length")},
+		{name: "Negative width", length: 30, width: -1, height: 10, wantErr: errs.NewValueIsInvalidError("width")},
+		{name: "Zero height", length: 30, width: 20, height: 0, wantErr: errs.NewValueIsInvalidError("height")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := order.NewDimensions(tt.length, tt.width, tt.height)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+				assert.False(t, got.IsValid())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, got.IsValid())
+			assert.Equal(t, tt.length, got.Length())
+			assert.Equal(t, tt.width, got.Width())
+			assert.Equal(t, tt.height, got.Height())
+		})
+	}
+}
+
+func TestNewItem(t *testing.T) {
+	goodID := uuid.New()
+
+	item, err := order.NewItem(goodID, "Кофе", 2)
+	require.NoError(t, err)
+	assert.Equal(t, goodID, item.GoodID())
+	assert.Equal(t, "Кофе", item.Title())
+	assert.Equal(t, 2, item.Quantity())
+
+	_, err = order.NewItem(uuid.Nil, "Кофе", 2)
+	assert.Equal(t, errs.NewValueIsInvalidError("goodID").Error(), err.Error())
+
+	_, err = order.NewItem(goodID, " ", 2)
+	assert.Equal(t, errs.NewValueIsInvalidError("title").Error(), err.Error())
+
+	_, err = order.NewItem(goodID, "Кофе", 0)
+	assert.Equal(t, errs.NewValueIsInvalidError("quantity").Error(), err.Error())
+}
+
+func TestNewOrderWithDetails(t *testing.T) {
+	dimensions, err := order.NewDimensions(30, 20, 10)
+	require.NoError(t, err)
+
+	item, err := order.NewItem(uuid.New(), "Кофе", 2)
+	require.NoError(t, err)
+
+	items := []order.Item{item}
+	details, err := order.NewDetails(1500, dimensions, items)
+	require.NoError(t, err)
+
+	// изменение исходного списка не меняет заказ
+	items[0] = order.Item{}
+
+	o, err := order.NewOrderWithDetails(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5, details)
+	require.NoError(t, err)
+	assert.Equal(t, 1500, o.Weight())
+	assert.Equal(t, dimensions, o.Details().Dimensions())
+	assert.Equal(t, []order.Item{item}, o.Details().Items())
+
+	_, err = order.NewDetails(-1, order.Dimensions{}, nil)
+	assert.Equal(t, errs.NewValueIsInvalidError("weight").Error(), err.Error())
+
+	plain, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MaxLocation(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, 0, plain.Weight())
+	assert.False(t, plain.Details().Dimensions().IsValid())
+	assert.Empty(t, plain.Details().Items())
+}
+
+func TestDetails_Equals(t *testing.T) {
+	dimensions, err := order.NewDimensions(30, 20, 10)
+	require.NoError(t, err)
+
+	item, err := order.NewItem(uuid.New(), "Кофе", 2)
+	require.NoError(t, err)
+
+	details, err := order.NewDetails(1500, dimensions, []order.Item{item})
+	require.NoError(t, err)
+
+	same, err := order.NewDetails(1500, dimensions, []order.Item{item})
+	require.NoError(t, err)
+
+	withoutItems, err := order.NewDetails(1500, dimensions, nil)
+	require.NoError(t, err)
+
+	heavier, err := order.NewDetails(2000, dimensions, []order.Item{item})
+	require.NoError(t, err)
+
+	assert.True(t, details.Equals(same))
+	assert.False(t, details.Equals(withoutItems))
+	assert.False(t, details.Equals(heavier))
+}
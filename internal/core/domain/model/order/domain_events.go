@@ -0,0 +1,178 @@
+package order
+
+import (
+	"delivery/internal/pkg/ddd"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ ddd.DomainEvent = OrderCreatedDomainEvent{}
+	_ ddd.DomainEvent = OrderAssignedDomainEvent{}
+	_ ddd.DomainEvent = OrderPickedUpDomainEvent{}
+	_ ddd.DomainEvent = OrderCompletedDomainEvent{}
+	_ ddd.DomainEvent = OrderCanceledDomainEvent{}
+	_ ddd.DomainEvent = OrderDeliveryAtRiskDomainEvent{}
+)
+
+type OrderCreatedDomainEvent struct {
+	ddd.BaseDomainEvent
+	OrderID         uuid.UUID
+	PickupLocationX int
+	PickupLocationY int
+	LocationX       int
+	LocationY       int
+	Volume          int
+	Weight          int
+	Status          Status
+}
+
+func NewOrderCreatedDomainEvent(aggregate *Order) OrderCreatedDomainEvent {
+	return OrderCreatedDomainEvent{
+		BaseDomainEvent: ddd.NewBaseDomainEvent("OrderCreatedDomainEvent"),
+		OrderID:         aggregate.ID(),
+		PickupLocationX: aggregate.PickupLocation().X(),
+		PickupLocationY: aggregate.PickupLocation().Y(),
+		LocationX:       aggregate.Location().X(),
+		LocationY:       aggregate.Location().Y(),
+		Volume:          aggregate.Volume(),
+		Weight:          aggregate.Weight(),
+		Status:          aggregate.Status(),
+	}
+}
+
+func (e OrderCreatedDomainEvent) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+func (e OrderCreatedDomainEvent) GetOrderStatus() Status {
+	return e.Status
+}
+
+type OrderAssignedDomainEvent struct {
+	ddd.BaseDomainEvent
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+	Status    Status
+}
+
+func NewOrderAssignedDomainEvent(aggregate *Order) OrderAssignedDomainEvent {
+	return OrderAssignedDomainEvent{
+		BaseDomainEvent: ddd.NewBaseDomainEvent("OrderAssignedDomainEvent"),
+		OrderID:         aggregate.ID(),
+		CourierID:       *aggregate.CourierID(),
+		Status:          aggregate.Status(),
+	}
+}
+
+func (e OrderAssignedDomainEvent) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+func (e OrderAssignedDomainEvent) GetOrderStatus() Status {
+	return e.Status
+}
+
+type OrderPickedUpDomainEvent struct {
+	ddd.BaseDomainEvent
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+	Status    Status
+}
+
+func NewOrderPickedUpDomainEvent(aggregate *Order) OrderPickedUpDomainEvent {
+	return OrderPickedUpDomainEvent{
+		BaseDomainEvent: ddd.NewBaseDomainEvent("OrderPickedUpDomainEvent"),
+		OrderID:         aggregate.ID(),
+		CourierID:       *aggregate.CourierID(),
+		Status:          aggregate.Status(),
+	}
+}
+
+func (e OrderPickedUpDomainEvent) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+func (e OrderPickedUpDomainEvent) GetOrderStatus() Status {
+	return e.Status
+}
+
+type OrderCompletedDomainEvent struct {
+	ddd.BaseDomainEvent
+	OrderID   uuid.UUID
+	CourierID uuid.UUID
+	Status    Status
+}
+
+func NewOrderCompletedDomainEvent(aggregate *Order) OrderCompletedDomainEvent {
+	return OrderCompletedDomainEvent{
+		BaseDomainEvent: ddd.NewBaseDomainEvent("OrderCompletedDomainEvent"),
+		OrderID:         aggregate.ID(),
+		CourierID:       *aggregate.CourierID(),
+		Status:          aggregate.Status(),
+	}
+}
+
+func (e OrderCompletedDomainEvent) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+func (e OrderCompletedDomainEvent) GetOrderStatus() Status {
+	return e.Status
+}
+
+type OrderCanceledDomainEvent struct {
+	ddd.BaseDomainEvent
+	OrderID uuid.UUID
+	// CourierID равен uuid.Nil, если заказ был отменен до назначения курьера
+	CourierID uuid.UUID
+	Status    Status
+}
+
+func NewOrderCanceledDomainEvent(aggregate *Order) OrderCanceledDomainEvent {
+	var courierID uuid.UUID
+	if aggregate.CourierID() != nil {
+		courierID = *aggregate.CourierID()
+	}
+
+	return OrderCanceledDomainEvent{
+		BaseDomainEvent: ddd.NewBaseDomainEvent("OrderCanceledDomainEvent"),
+		OrderID:         aggregate.ID(),
+		CourierID:       courierID,
+		Status:          aggregate.Status(),
+	}
+}
+
+func (e OrderCanceledDomainEvent) GetOrderID() uuid.UUID {
+	return e.OrderID
+}
+
+func (e OrderCanceledDomainEvent) GetOrderStatus() Status {
+	return e.Status
+}
+
+// OrderDeliveryAtRiskDomainEvent - заказ может не успеть к концу обещанного интервала доставки.
+// Статус заказа не меняется, поэтому событие не публикуется как смена статуса
+type OrderDeliveryAtRiskDomainEvent struct {
+	ddd.BaseDomainEvent
+	OrderID             uuid.UUID
+	CourierID           uuid.UUID
+	WindowTo            time.Time
+	EstimatedDeliveryAt time.Time
+}
+
+func NewOrderDeliveryAtRiskDomainEvent(aggregate *Order, eta time.Time) OrderDeliveryAtRiskDomainEvent {
+	var courierID uuid.UUID
+	if aggregate.CourierID() != nil {
+		courierID = *aggregate.CourierID()
+	}
+
+	return OrderDeliveryAtRiskDomainEvent{
+		BaseDomainEvent:     ddd.NewBaseDomainEvent("OrderDeliveryAtRiskDomainEvent"),
+		OrderID:             aggregate.ID(),
+		CourierID:           courierID,
+		WindowTo:            aggregate.DeliveryWindow().To(),
+		EstimatedDeliveryAt: eta.UTC(),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"delivery/internal/pkg/ddd"
 	"delivery/internal/pkg/errs"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,17 +15,36 @@ var (
 	ErrCourierAlreadyAssigned = errors.New("courier already assigned")
 	ErrOrderAlreadyCompleted  = errors.New("order already completed")
 	ErrOrderNotAssigned       = errors.New("order not assigned")
+	ErrOrderAlreadyCanceled   = errors.New("order already canceled")
+	ErrOrderNotPickedUp       = errors.New("order not picked up")
 )
 
 type Order struct {
-	baseAggregate *ddd.BaseAggregate[uuid.UUID]
-	courierID     *uuid.UUID
-	location      kernel.Location
-	volume        int
-	status        Status
+	baseAggregate  *ddd.BaseAggregate[uuid.UUID]
+	courierID      *uuid.UUID
+	pickupLocation kernel.Location
+	location       kernel.Location
+	volume         int
+	details        Details
+	priority       Priority
+	deliveryWindow DeliveryWindow
+	// atRisk - заказ может не успеть к концу обещанного интервала, событие об этом уже отправлено
+	atRisk bool
+	status Status
 }
 
-func NewOrder(id uuid.UUID, location kernel.Location, volume int) (*Order, error) {
+// NewOrder создает заказ, который нужно забрать в pickupLocation и доставить в location
+func NewOrder(id uuid.UUID, pickupLocation kernel.Location, location kernel.Location, volume int) (*Order, error) {
+	return NewOrderWithDetails(id, pickupLocation, location, volume, Details{})
+}
+
+// NewOrderWithDetails создает заказ с известными весом, габаритами и составом
+func NewOrderWithDetails(
+	id uuid.UUID,
+	pickupLocation kernel.Location,
+	location kernel.Location,
+	volume int,
+	details Details) (*Order, error) {
 	if id == uuid.Nil {
 		return nil, errs.NewValueIsInvalidError("id")
 	}
@@ -37,21 +57,48 @@ func NewOrder(id uuid.UUID, location kernel.Location, volume int) (*Order, error
 		return nil, errs.NewValueIsInvalidError("location")
 	}
 
-	return &Order{
-		baseAggregate: ddd.NewBaseAggregate(id),
-		location:      location,
-		volume:        volume,
-		status:        StatusCreated,
-	}, nil
+	if !pickupLocation.IsValid() {
+		return nil, errs.NewValueIsInvalidError("pickupLocation")
+	}
+
+	o := &Order{
+		baseAggregate:  ddd.NewBaseAggregate(id),
+		pickupLocation: pickupLocation,
+		location:       location,
+		volume:         volume,
+		details:        details,
+		priority:       PriorityNormal,
+		status:         StatusCreated,
+	}
+
+	o.RaiseDomainEvent(NewOrderCreatedDomainEvent(o))
+
+	return o, nil
 }
 
-func RestoreOrder(id uuid.UUID, courierID *uuid.UUID, location kernel.Location, volume int, status Status) *Order {
+func RestoreOrder(
+	id uuid.UUID,
+	courierID *uuid.UUID,
+	pickupLocation kernel.Location,
+	location kernel.Location,
+	volume int,
+	details Details,
+	priority Priority,
+	deliveryWindow DeliveryWindow,
+	atRisk bool,
+	status Status,
+	version int64) *Order {
 	return &Order{
-		baseAggregate: ddd.NewBaseAggregate(id),
-		courierID:     courierID,
-		location:      location,
-		volume:        volume,
-		status:        status,
+		baseAggregate:  ddd.RestoreBaseAggregate(id, version),
+		courierID:      courierID,
+		pickupLocation: pickupLocation,
+		location:       location,
+		volume:         volume,
+		details:        details,
+		priority:       priority,
+		deliveryWindow: deliveryWindow,
+		atRisk:         atRisk,
+		status:         status,
 	}
 }
 
@@ -59,18 +106,108 @@ func (o *Order) ID() uuid.UUID {
 	return o.baseAggregate.ID()
 }
 
+func (o *Order) Version() int64 {
+	return o.baseAggregate.Version()
+}
+
+func (o *Order) IncrementVersion() {
+	o.baseAggregate.IncrementVersion()
+}
+
 func (o *Order) CourierID() *uuid.UUID {
 	return o.courierID
 }
 
+// PickupLocation возвращает место, где курьер забирает заказ
+func (o *Order) PickupLocation() kernel.Location {
+	return o.pickupLocation
+}
+
+// Location возвращает место доставки заказа
 func (o *Order) Location() kernel.Location {
 	return o.location
 }
 
+// DeliveryDistance возвращает расстояние от места забора до места доставки
+func (o *Order) DeliveryDistance() float64 {
+	return kernel.CurrentDistanceMetric().Distance(o.pickupLocation, o.location)
+}
+
 func (o *Order) Volume() int {
 	return o.volume
 }
 
+// Weight возвращает вес заказа в граммах или 0, если вес неизвестен
+func (o *Order) Weight() int {
+	return o.details.Weight()
+}
+
+func (o *Order) Details() Details {
+	return o.details
+}
+
+func (o *Order) Priority() Priority {
+	return o.priority
+}
+
+// DeliveryWindow возвращает обещанный интервал доставки. Если интервал не обещан, IsValid возвращает false
+func (o *Order) DeliveryWindow() DeliveryWindow {
+	return o.deliveryWindow
+}
+
+// IsAtRisk проверяет, отмечен ли заказ как рискующий не успеть к концу интервала доставки
+func (o *Order) IsAtRisk() bool {
+	return o.atRisk
+}
+
+// Prioritize меняет срочность заказа, пока он не назначен курьеру
+func (o *Order) Prioritize(priority Priority) error {
+	if !priority.IsValid() {
+		return errs.NewValueIsInvalidError("priority")
+	}
+
+	if o.status != StatusCreated {
+		return ErrInvalidOrderStatus
+	}
+
+	o.priority = priority
+
+	return nil
+}
+
+// PromiseDeliveryWindow задает интервал доставки, пока заказ не назначен курьеру
+func (o *Order) PromiseDeliveryWindow(window DeliveryWindow) error {
+	if !window.IsValid() {
+		return errs.NewValueIsInvalidError("window")
+	}
+
+	if o.status != StatusCreated {
+		return ErrInvalidOrderStatus
+	}
+
+	o.deliveryWindow = window
+
+	return nil
+}
+
+// CheckDeliveryRisk отмечает заказ, если ожидаемое время доставки eta выходит за обещанный интервал.
+// Событие поднимается один раз, возвращается true, если заказ отмечен этим вызовом
+func (o *Order) CheckDeliveryRisk(eta time.Time) bool {
+	if o.atRisk || !o.deliveryWindow.IsMissedBy(eta) {
+		return false
+	}
+
+	if o.status == StatusCompleted || o.status == StatusCanceled {
+		return false
+	}
+
+	o.atRisk = true
+
+	o.RaiseDomainEvent(NewOrderDeliveryAtRiskDomainEvent(o, eta))
+
+	return true
+}
+
 func (o *Order) Status() Status {
 	return o.status
 }
@@ -107,6 +244,29 @@ func (o *Order) Assign(courierID uuid.UUID) error {
 	o.courierID = &courierID
 	o.status = StatusAssigned
 
+	o.RaiseDomainEvent(NewOrderAssignedDomainEvent(o))
+
+	return nil
+}
+
+// PickUp отмечает, что курьер забрал заказ и везет его получателю
+func (o *Order) PickUp() error {
+	if o.status == StatusPickedUp || o.status == StatusCompleted {
+		return ErrInvalidOrderStatus
+	}
+
+	if o.status != StatusAssigned {
+		return ErrOrderNotAssigned
+	}
+
+	if o.courierID == nil {
+		return errs.NewValueIsInvalidError("courierID")
+	}
+
+	o.status = StatusPickedUp
+
+	o.RaiseDomainEvent(NewOrderPickedUpDomainEvent(o))
+
 	return nil
 }
 
@@ -115,7 +275,11 @@ func (o *Order) Complete() error {
 		return ErrOrderAlreadyCompleted
 	}
 
-	if o.status != StatusAssigned {
+	if o.status == StatusAssigned {
+		return ErrOrderNotPickedUp
+	}
+
+	if o.status != StatusPickedUp {
 		return ErrOrderNotAssigned
 	}
 
@@ -125,5 +289,29 @@ func (o *Order) Complete() error {
 
 	o.status = StatusCompleted
 
+	o.RaiseDomainEvent(NewOrderCompletedDomainEvent(o))
+
+	return nil
+}
+
+// Cancel отменяет заказ, который курьер еще не забрал.
+// Назначенный курьер сохраняется, чтобы можно было освободить его место хранения
+func (o *Order) Cancel() error {
+	if o.status == StatusCanceled {
+		return ErrOrderAlreadyCanceled
+	}
+
+	if o.status == StatusCompleted {
+		return ErrOrderAlreadyCompleted
+	}
+
+	if o.status != StatusCreated && o.status != StatusAssigned {
+		return ErrInvalidOrderStatus
+	}
+
+	o.status = StatusCanceled
+
+	o.RaiseDomainEvent(NewOrderCanceledDomainEvent(o))
+
 	return nil
 }
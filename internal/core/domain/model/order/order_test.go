@@ -52,7 +52,7 @@ func TestNewOrder(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := order.NewOrder(tt.orderID, tt.location, tt.volume)
+			got, err := order.NewOrder(tt.orderID, tt.location, tt.location, tt.volume)
 			if tt.wantErr != nil {
 				assert.Equal(t, tt.wantErr.Error(), err.Error())
 				assert.Nil(t, got)
@@ -152,6 +152,9 @@ func TestOrder_Complete(t *testing.T) {
 	err := o.Assign(uuid.New())
 	require.Nil(t, err)
 
+	err = o.PickUp()
+	require.Nil(t, err)
+
 	err = o.Complete()
 	require.Nil(t, err)
 }
@@ -161,6 +164,9 @@ func TestOrder_CompleteAlreadyCompletedOrder(t *testing.T) {
 	err := o.Assign(uuid.New())
 	require.Nil(t, err)
 
+	err = o.PickUp()
+	require.Nil(t, err)
+
 	err = o.Complete()
 	require.Nil(t, err)
 
@@ -186,8 +192,86 @@ func TestOrder_CompleteCreatedOrder(t *testing.T) {
 	require.ErrorIs(t, order.ErrOrderNotAssigned, err)
 }
 
+func TestOrder_CompleteAssignedOrder(t *testing.T) {
+	o := createValidOrder(kernel.RandomLocation(), 10)
+	require.NoError(t, o.Assign(uuid.New()))
+
+	err := o.Complete()
+
+	require.ErrorIs(t, err, order.ErrOrderNotPickedUp)
+	assert.Equal(t, order.StatusAssigned, o.Status())
+}
+
+func TestOrder_PickUp(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(o *order.Order)
+		wantErr error
+	}{
+		{
+			name:    "Created",
+			prepare: func(o *order.Order) {},
+			wantErr: order.ErrOrderNotAssigned,
+		},
+		{
+			name: "Assigned",
+			prepare: func(o *order.Order) {
+				require.NoError(t, o.Assign(uuid.New()))
+			},
+		},
+		{
+			name: "PickedUp",
+			prepare: func(o *order.Order) {
+				require.NoError(t, o.Assign(uuid.New()))
+				require.NoError(t, o.PickUp())
+			},
+			wantErr: order.ErrInvalidOrderStatus,
+		},
+		{
+			name: "Canceled",
+			prepare: func(o *order.Order) {
+				require.NoError(t, o.Cancel())
+			},
+			wantErr: order.ErrOrderNotAssigned,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := createValidOrder(kernel.RandomLocation(), 10)
+			tt.prepare(o)
+			status := o.Status()
+
+			err := o.PickUp()
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, status, o.Status())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, order.StatusPickedUp, o.Status())
+		})
+	}
+}
+
+func TestOrder_DeliveryDistance(t *testing.T) {
+	pickup, err := kernel.NewLocation(1, 1)
+	require.NoError(t, err)
+	location, err := kernel.NewLocation(4, 5)
+	require.NoError(t, err)
+
+	o, err := order.NewOrder(uuid.New(), pickup, location, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, pickup, o.PickupLocation())
+	assert.Equal(t, location, o.Location())
+	assert.Equal(t, 7.0, o.DeliveryDistance())
+}
+
 func createValidOrder(location kernel.Location, volume int) *order.Order {
-	o, err := order.NewOrder(uuid.New(), location, volume)
+	o, err := order.NewOrder(uuid.New(), location, location, volume)
 
 	if err != nil {
 		panic(err)
@@ -195,3 +279,167 @@ func createValidOrder(location kernel.Location, volume int) *order.Order {
 
 	return o
 }
+
+func TestNewOrder_RaisesCreatedDomainEvent(t *testing.T) {
+	o := createValidOrder(kernel.RandomLocation(), 10)
+
+	events := o.GetDomainEvents()
+	require.Len(t, events, 1)
+
+	event, ok := events[0].(order.OrderCreatedDomainEvent)
+	require.True(t, ok)
+	assert.NotEqual(t, uuid.Nil, event.GetID())
+	assert.Equal(t, "OrderCreatedDomainEvent", event.GetName())
+	assert.False(t, event.GetOccurredAt().IsZero())
+	assert.Equal(t, o.ID(), event.OrderID)
+	assert.Equal(t, o.Location().X(), event.LocationX)
+	assert.Equal(t, o.Location().Y(), event.LocationY)
+	assert.Equal(t, 10, event.Volume)
+	assert.Equal(t, order.StatusCreated, event.Status)
+}
+
+func TestOrder_AssignAndComplete_RaiseDomainEvents(t *testing.T) {
+	o := createValidOrder(kernel.RandomLocation(), 10)
+	o.ClearDomainEvents()
+	courierID := uuid.New()
+
+	err := o.Assign(courierID)
+	require.Nil(t, err)
+
+	err = o.PickUp()
+	require.Nil(t, err)
+
+	err = o.Complete()
+	require.Nil(t, err)
+
+	events := o.GetDomainEvents()
+	require.Len(t, events, 3)
+
+	assigned, ok := events[0].(order.OrderAssignedDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, o.ID(), assigned.OrderID)
+	assert.Equal(t, courierID, assigned.CourierID)
+	assert.Equal(t, order.StatusAssigned, assigned.Status)
+
+	pickedUp, ok := events[1].(order.OrderPickedUpDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, o.ID(), pickedUp.OrderID)
+	assert.Equal(t, courierID, pickedUp.CourierID)
+	assert.Equal(t, order.StatusPickedUp, pickedUp.Status)
+
+	completed, ok := events[2].(order.OrderCompletedDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, o.ID(), completed.OrderID)
+	assert.Equal(t, courierID, completed.CourierID)
+	assert.Equal(t, order.StatusCompleted, completed.Status)
+}
+
+func TestOrder_FailedTransition_DoesNotRaiseDomainEvent(t *testing.T) {
+	o := createValidOrder(kernel.RandomLocation(), 10)
+	o.ClearDomainEvents()
+
+	err := o.Complete()
+	require.Error(t, err)
+
+	assert.Empty(t, o.GetDomainEvents())
+}
+
+func TestOrder_Version(t *testing.T) {
+	o := createValidOrder(kernel.RandomLocation(), 10)
+	assert.Equal(t, int64(0), o.Version())
+
+	restored := order.RestoreOrder(o.ID(), nil, o.PickupLocation(), o.Location(), o.Volume(), o.Details(), o.Priority(), o.DeliveryWindow(), o.IsAtRisk(), o.Status(), 7)
+	assert.Equal(t, int64(7), restored.Version())
+
+	restored.IncrementVersion()
+	assert.Equal(t, int64(8), restored.Version())
+}
+
+func TestOrder_Cancel(t *testing.T) {
+	courierID := uuid.New()
+
+	tests := []struct {
+		name    string
+		order   func() *order.Order
+		wantErr error
+	}{
+		{
+			name: "Created order",
+			order: func() *order.Order {
+				return createValidOrder(kernel.RandomLocation(), 10)
+			},
+		},
+		{
+			name: "Assigned order",
+			order: func() *order.Order {
+				o := createValidOrder(kernel.RandomLocation(), 10)
+				require.NoError(t, o.Assign(courierID))
+				return o
+			},
+		},
+		{
+			name: "Picked up order",
+			order: func() *order.Order {
+				o := createValidOrder(kernel.RandomLocation(), 10)
+				require.NoError(t, o.Assign(courierID))
+				require.NoError(t, o.PickUp())
+				return o
+			},
+			wantErr: order.ErrInvalidOrderStatus,
+		},
+		{
+			name: "Completed order",
+			order: func() *order.Order {
+				o := createValidOrder(kernel.RandomLocation(), 10)
+				require.NoError(t, o.Assign(courierID))
+				require.NoError(t, o.PickUp())
+				require.NoError(t, o.Complete())
+				return o
+			},
+			wantErr: order.ErrOrderAlreadyCompleted,
+		},
+		{
+			name: "Canceled order",
+			order: func() *order.Order {
+				o := createValidOrder(kernel.RandomLocation(), 10)
+				require.NoError(t, o.Cancel())
+				return o
+			},
+			wantErr: order.ErrOrderAlreadyCanceled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := tt.order()
+			statusBefore := o.Status()
+			o.ClearDomainEvents()
+
+			err := o.Cancel()
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, statusBefore, o.Status())
+				assert.Empty(t, o.GetDomainEvents())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, order.StatusCanceled, o.Status())
+
+			events := o.GetDomainEvents()
+			require.Len(t, events, 1)
+			canceled, ok := events[0].(order.OrderCanceledDomainEvent)
+			require.True(t, ok)
+			assert.Equal(t, o.ID(), canceled.OrderID)
+			assert.Equal(t, order.StatusCanceled, canceled.Status)
+		})
+	}
+}
+
+func TestOrder_CanceledOrderCanNotBeAssignedOrCompleted(t *testing.T) {
+	o := createValidOrder(kernel.RandomLocation(), 10)
+	require.NoError(t, o.Cancel())
+
+	assert.ErrorIs(t, o.Assign(uuid.New()), order.ErrInvalidOrderStatus)
+	assert.ErrorIs(t, o.Complete(), order.ErrOrderNotAssigned)
+}
@@ -0,0 +1,41 @@
+package order
+
+import "delivery/internal/pkg/errs"
+
+// Priority - срочность заказа. Чем больше значение, тем раньше заказ назначается курьеру
+type Priority int
+
+const (
+	PriorityLow    Priority = 1
+	PriorityNormal Priority = 2
+	PriorityHigh   Priority = 3
+)
+
+var priorityNames = map[Priority]string{
+	PriorityLow:    "low",
+	PriorityNormal: "normal",
+	PriorityHigh:   "high",
+}
+
+func ParsePriority(value string) (Priority, error) {
+	for p, name := range priorityNames {
+		if name == value {
+			return p, nil
+		}
+	}
+
+	return 0, errs.NewValueIsInvalidError("priority")
+}
+
+func (p Priority) IsValid() bool {
+	_, ok := priorityNames[p]
+	return ok
+}
+
+func (p Priority) Equals(other Priority) bool {
+	return p == other
+}
+
+func (p Priority) String() string {
+	return priorityNames[p]
+}
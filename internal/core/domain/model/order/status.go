@@ -1,10 +1,15 @@
 package order
 
 const (
-	StatusEmpty     Status = ""
-	StatusCreated   Status = "created"
-	StatusAssigned  Status = "assigned"
+	StatusEmpty   Status = ""
+	StatusCreated Status = "created"
+	// StatusAssigned - курьер назначен и едет к месту забора заказа
+	StatusAssigned Status = "assigned"
+	// StatusPickedUp - курьер забрал заказ и везет его получателю
+	StatusPickedUp Status = "picked_up"
+	// StatusCompleted - заказ доставлен получателю
 	StatusCompleted Status = "completed"
+	StatusCanceled  Status = "canceled"
 )
 
 type Status string
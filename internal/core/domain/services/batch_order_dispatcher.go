@@ -0,0 +1,155 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"math"
+	"time"
+)
+
+// infeasibleCost - стоимость пары, в которой курьер не может взять заказ.
+// Такие пары попадают в решение только когда других вариантов нет и затем отбрасываются.
+// Стоимость - время доставки в наносекундах, поэтому недостижимый заказ, время до которого
+// равно math.MaxInt64, тоже получает infeasibleCost
+const infeasibleCost = float64(math.MaxInt64)
+
+// clampCost заменяет недостижимую или неопределенную стоимость на infeasibleCost:
+// с бесконечной стоимостью венгерский алгоритм не сходится
+func clampCost(cost float64) float64 {
+	if math.IsNaN(cost) || cost > infeasibleCost {
+		return infeasibleCost
+	}
+
+	return cost
+}
+
+// Assignment - назначение заказа курьеру
+type Assignment struct {
+	Order   *order.Order
+	Courier *courier.Courier
+}
+
+// BatchOrderDispatcher распределяет сразу все ожидающие заказы между курьерами
+type BatchOrderDispatcher interface {
+	Dispatch(orders []*order.Order, couriers []*courier.Courier) ([]Assignment, error)
+}
+
+var _ BatchOrderDispatcher = &batchOrderDispatcher{}
+
+// batchOrderDispatcher минимизирует суммарное время, за которое курьеры доберутся до заказов.
+// За один раунд каждый курьер получает не больше одного заказа; раунды повторяются,
+// пока у курьеров остается место для нераспределенных заказов
+type batchOrderDispatcher struct{}
+
+func NewBatchOrderDispatcher() BatchOrderDispatcher {
+	return &batchOrderDispatcher{}
+}
+
+func (d batchOrderDispatcher) Dispatch(orders []*order.Order, couriers []*courier.Courier) ([]Assignment, error) {
+	for _, o := range orders {
+		if o == nil {
+			return nil, errs.NewValueIsRequiredError("order")
+		}
+
+		if o.Status() != order.StatusCreated {
+			return nil, ErrOrderIsAlreadyAssigned
+		}
+	}
+
+	for _, c := range couriers {
+		if c == nil {
+			return nil, errs.NewValueIsRequiredError("courier")
+		}
+	}
+
+	assignments := make([]Assignment, 0, len(orders))
+	pending := orders
+	for len(pending) > 0 && len(couriers) > 0 {
+		roundAssignments, err := d.dispatchRound(pending, couriers)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(roundAssignments) == 0 {
+			break
+		}
+
+		assigned := make(map[*order.Order]bool, len(roundAssignments))
+		for _, a := range roundAssignments {
+			assigned[a.Order] = true
+		}
+
+		rest := make([]*order.Order, 0, len(pending)-len(roundAssignments))
+		for _, o := range pending {
+			if !assigned[o] {
+				rest = append(rest, o)
+			}
+		}
+
+		assignments = append(assignments, roundAssignments...)
+		pending = rest
+	}
+
+	return assignments, nil
+}
+
+func (d batchOrderDispatcher) dispatchRound(orders []*order.Order, couriers []*courier.Courier) ([]Assignment, error) {
+	now := time.Now()
+
+	cost := make([][]float64, len(orders))
+	for i, o := range orders {
+		cost[i] = make([]float64, len(couriers))
+		for j, c := range couriers {
+			canTake, err := c.CanTakeOrder(o)
+			if err != nil {
+				return nil, err
+			}
+
+			if !canTake || !c.IsOnShift() || !c.CanCarry(o) {
+				cost[i][j] = infeasibleCost
+				continue
+			}
+
+			meets, err := meetsDeliveryWindow(c, o, now)
+			if err != nil {
+				return nil, err
+			}
+
+			if !meets {
+				cost[i][j] = infeasibleCost
+				continue
+			}
+
+			t, err := c.EstimateTimeToDeliver(o)
+			if err != nil {
+				return nil, err
+			}
+
+			cost[i][j] = clampCost(float64(t))
+		}
+	}
+
+	assignments := make([]Assignment, 0)
+	for i, j := range solveAssignment(cost) {
+		if j < 0 || cost[i][j] >= infeasibleCost {
+			continue
+		}
+
+		o, c := orders[i], couriers[j]
+
+		err := c.TakeOrder(o)
+		if err != nil {
+			return nil, err
+		}
+
+		err = o.Assign(c.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		assignments = append(assignments, Assignment{Order: o, Courier: c})
+	}
+
+	return assignments, nil
+}
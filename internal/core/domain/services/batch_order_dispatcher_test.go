@@ -0,0 +1,214 @@
+package services_test
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/tests"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchOrderDispatcher_Dispatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		orders   func() []*order.Order
+		couriers func() []*courier.Courier
+		// want[i] - индекс курьера для i-го заказа, -1 если заказ не назначен
+		want []int
+	}{
+		{
+			name: "Minimizes total time instead of taking nearest courier for first order",
+			orders: func() []*order.Order {
+				return []*order.Order{
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(3, 1), 5),
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 5),
+				}
+			},
+			couriers: func() []*courier.Courier {
+				return []*courier.Courier{
+					tests.CreateCourier("Bob", 1, tests.CreateLocation(2, 1)),
+					tests.CreateCourier("Alice", 1, tests.CreateLocation(5, 1)),
+				}
+			},
+			want: []int{1, 0},
+		},
+		{
+			name: "Skips couriers not on shift",
+			orders: func() []*order.Order {
+				return []*order.Order{
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 5),
+				}
+			},
+			couriers: func() []*courier.Courier {
+				offShift, err := courier.NewCourier("Bob", 1, tests.CreateLocation(1, 1))
+				require.NoError(t, err)
+
+				return []*courier.Courier{
+					offShift,
+					tests.CreateCourier("Alice", 1, tests.CreateLocation(5, 5)),
+				}
+			},
+			want: []int{1},
+		},
+		{
+			name: "Respects vehicle restrictions",
+			orders: func() []*order.Order {
+				return []*order.Order{
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 40),
+				}
+			},
+			couriers: func() []*courier.Courier {
+				walker := tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1))
+				require.NoError(t, walker.AddStoragePlace("Trolley", 50, 0))
+
+				return []*courier.Courier{
+					walker,
+					tests.CreateCourierWithVehicle("Alice", courier.VehicleCar, 1, tests.CreateLocation(5, 5)),
+				}
+			},
+			want: []int{1},
+		},
+		{
+			name: "Respects delivery window",
+			orders: func() []*order.Order {
+				o := tests.CreateOrder(uuid.New(), tests.CreateLocation(10, 10), 5)
+				window, err := order.NewDeliveryWindow(time.Now(), time.Now().Add(10*kernel.StepDuration))
+				require.NoError(t, err)
+				require.NoError(t, o.PromiseDeliveryWindow(window))
+
+				return []*order.Order{o}
+			},
+			couriers: func() []*courier.Courier {
+				return []*courier.Courier{
+					tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1)),
+				}
+			},
+			want: []int{-1},
+		},
+		{
+			name: "More couriers than orders",
+			orders: func() []*order.Order {
+				return []*order.Order{
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(9, 9), 5),
+				}
+			},
+			couriers: func() []*courier.Courier {
+				return []*courier.Courier{
+					tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1)),
+					tests.CreateCourier("Alice", 1, tests.CreateLocation(8, 8)),
+					tests.CreateCourier("Eve", 1, tests.CreateLocation(5, 5)),
+				}
+			},
+			want: []int{1},
+		},
+		{
+			name: "Courier with several places takes several orders",
+			orders: func() []*order.Order {
+				return []*order.Order{
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 5),
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(2, 2), 5),
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(3, 3), 5),
+				}
+			},
+			couriers: func() []*courier.Courier {
+				c := tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1))
+				require.NoError(t, c.AddStoragePlace("Box", 10, 0))
+				require.NoError(t, c.AddStoragePlace("Trunk", 10, 0))
+				return []*courier.Courier{c}
+			},
+			want: []int{0, 0, 0},
+		},
+		{
+			name: "Orders without free place stay unassigned",
+			orders: func() []*order.Order {
+				return []*order.Order{
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 5),
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(2, 2), 5),
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(3, 3), 50),
+				}
+			},
+			couriers: func() []*courier.Courier {
+				return []*courier.Courier{
+					tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1)),
+				}
+			},
+			want: []int{0, -1, -1},
+		},
+		{
+			name: "No couriers",
+			orders: func() []*order.Order {
+				return []*order.Order{
+					tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 5),
+				}
+			},
+			couriers: func() []*courier.Courier {
+				return []*courier.Courier{}
+			},
+			want: []int{-1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			orders := tc.orders()
+			couriers := tc.couriers()
+
+			got, err := services.NewBatchOrderDispatcher().Dispatch(orders, couriers)
+			require.NoError(t, err)
+
+			assignedTo := make(map[*order.Order]*courier.Courier, len(got))
+			for _, a := range got {
+				assignedTo[a.Order] = a.Courier
+			}
+
+			for i, o := range orders {
+				if tc.want[i] < 0 {
+					assert.NotContains(t, assignedTo, o)
+					assert.Equal(t, order.StatusCreated, o.Status())
+					continue
+				}
+
+				c := couriers[tc.want[i]]
+				assert.Equal(t, c, assignedTo[o])
+				assert.Equal(t, order.StatusAssigned, o.Status())
+				assert.Equal(t, c.Id(), *o.CourierID())
+				assert.True(t, c.HasOrder(o.ID()))
+			}
+		})
+	}
+}
+
+func TestBatchOrderDispatcher_Dispatch_AssignedOrder(t *testing.T) {
+	c := tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1))
+	o := tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 5)
+	require.NoError(t, o.Assign(c.Id()))
+
+	got, err := services.NewBatchOrderDispatcher().Dispatch([]*order.Order{o}, []*courier.Courier{c})
+	assert.ErrorIs(t, err, services.ErrOrderIsAlreadyAssigned)
+	assert.Nil(t, got)
+}
+
+func TestBatchOrderDispatcher_Dispatch_UnreachableCourier(t *testing.T) {
+	unreachable := tests.CreateLocation(5, 5)
+	setUnreachableFrom(t, unreachable)
+
+	o := tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 5)
+	bob := tests.CreateCourier("Bob", 1, unreachable)
+	alice := tests.CreateCourier("Alice", 1, tests.CreateLocation(3, 3))
+
+	got, err := services.NewBatchOrderDispatcher().Dispatch([]*order.Order{o}, []*courier.Courier{bob})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	got, err = services.NewBatchOrderDispatcher().Dispatch([]*order.Order{o}, []*courier.Courier{bob, alice})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, alice, got[0].Courier)
+	assert.False(t, bob.HasOrder(o.ID()))
+}
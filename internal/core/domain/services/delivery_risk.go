@@ -0,0 +1,41 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"time"
+)
+
+// CheckUndispatchedRisk отмечает созданный заказ, который не удалось назначить, если даже самый быстрый
+// курьер на смене, способный его везти, не успеет к концу интервала. Без таких курьеров заказ отмечается,
+// только когда интервал уже прошел
+func CheckUndispatchedRisk(o *order.Order, couriers []*courier.Courier, now time.Time) (bool, error) {
+	if o == nil {
+		return false, errs.NewValueIsRequiredError("order")
+	}
+
+	if o.Status() != order.StatusCreated || !o.DeliveryWindow().IsValid() {
+		return false, nil
+	}
+
+	var bestETA time.Duration
+	found := false
+	for _, c := range couriers {
+		if !c.IsOnShift() || !c.CanCarry(o) {
+			continue
+		}
+
+		eta, err := c.EstimateTimeToDeliver(o)
+		if err != nil {
+			return false, err
+		}
+
+		if !found || eta < bestETA {
+			bestETA = eta
+			found = true
+		}
+	}
+
+	return o.CheckDeliveryRisk(now.Add(bestETA)), nil
+}
@@ -0,0 +1,73 @@
+package services_test
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/tests"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckUndispatchedRisk(t *testing.T) {
+	now := time.Now()
+
+	createOrder := func() *order.Order {
+		o := tests.CreateOrder(uuid.New(), tests.CreateLocation(10, 10), 5)
+		window, err := order.NewDeliveryWindow(now, now.Add(10*kernel.StepDuration))
+		require.NoError(t, err)
+		require.NoError(t, o.PromiseDeliveryWindow(window))
+
+		return o
+	}
+
+	testCases := []struct {
+		name     string
+		couriers []*courier.Courier
+		now      time.Time
+		want     bool
+	}{
+		{
+			name:     "fastest courier is late",
+			couriers: []*courier.Courier{tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1))},
+			now:      now,
+			want:     true,
+		},
+		{
+			name:     "fastest courier is in time",
+			couriers: []*courier.Courier{tests.CreateCourier("Alice", 1, tests.CreateLocation(8, 8))},
+			now:      now,
+			want:     false,
+		},
+		{
+			name:     "no couriers before window end",
+			couriers: nil,
+			now:      now,
+			want:     false,
+		},
+		{
+			name:     "no couriers after window end",
+			couriers: nil,
+			now:      now.Add(11 * kernel.StepDuration),
+			want:     true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			o := createOrder()
+			o.ClearDomainEvents()
+
+			got, err := services.CheckUndispatchedRisk(o, tt.couriers, tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, o.IsAtRisk())
+			assert.Equal(t, tt.want, len(o.GetDomainEvents()) == 1)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"delivery/internal/pkg/errs"
+)
+
+type DispatchStrategy string
+
+const (
+	DispatchStrategyNearestTime DispatchStrategy = "nearest-time"
+	DispatchStrategyLeastLoaded DispatchStrategy = "least-loaded"
+	DispatchStrategyRoundRobin  DispatchStrategy = "round-robin"
+	DispatchStrategyWeighted    DispatchStrategy = "weighted"
+)
+
+// NewOrderDispatcherByStrategy создает диспетчера по названию стратегии.
+// Пустое название соответствует стратегии по умолчанию - ближайшему по времени курьеру
+func NewOrderDispatcherByStrategy(strategy DispatchStrategy) (OrderDispatcher, error) {
+	switch strategy {
+	case "", DispatchStrategyNearestTime:
+		return NewOrderDispatcher(), nil
+	case DispatchStrategyLeastLoaded:
+		return NewLeastLoadedOrderDispatcher(), nil
+	case DispatchStrategyRoundRobin:
+		return NewRoundRobinOrderDispatcher(), nil
+	case DispatchStrategyWeighted:
+		return NewWeightedOrderDispatcher(DefaultDispatchWeights())
+	default:
+		return nil, errs.NewValueIsInvalidError("dispatch strategy")
+	}
+}
@@ -0,0 +1,54 @@
+package services_test
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tests"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrderDispatcherByStrategy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy services.DispatchStrategy
+		wantErr  error
+	}{
+		{name: "Default", strategy: ""},
+		{name: "Nearest time", strategy: services.DispatchStrategyNearestTime},
+		{name: "Least loaded", strategy: services.DispatchStrategyLeastLoaded},
+		{name: "Round robin", strategy: services.DispatchStrategyRoundRobin},
+		{name: "Weighted", strategy: services.DispatchStrategyWeighted},
+		{
+			name:     "Unknown",
+			strategy: "random",
+			wantErr:  errs.NewValueIsInvalidError("dispatch strategy"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dispatcher, err := services.NewOrderDispatcherByStrategy(tc.strategy)
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+				assert.Nil(t, dispatcher)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			c := tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1))
+			o := tests.CreateOrder(uuid.New(), tests.CreateLocation(2, 2), 1)
+
+			got, err := dispatcher.Dispatch(o, []*courier.Courier{c})
+			require.NoError(t, err)
+			assert.Equal(t, c, got)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolveAssignment(t *testing.T) {
+	inf := math.Inf(1)
+
+	testCases := []struct {
+		name string
+		cost [][]float64
+		want []int
+	}{
+		{
+			name: "Minimizes total cost",
+			cost: [][]float64{{4, 1}, {2, 3}},
+			want: []int{1, 0},
+		},
+		{
+			name: "More rows than columns",
+			cost: [][]float64{{1}, {2}},
+			want: []int{0, -1},
+		},
+		{
+			name: "Single infinite cost",
+			cost: [][]float64{{inf}},
+			want: []int{0},
+		},
+		{
+			name: "Infinite costs around a finite one",
+			cost: [][]float64{{1, inf}, {inf, inf}},
+			want: []int{0, 1},
+		},
+		{
+			name: "Undefined cost",
+			cost: [][]float64{{math.NaN(), 1}, {2, math.NaN()}},
+			want: []int{1, 0},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, solveAssignment(tt.cost))
+		})
+	}
+}
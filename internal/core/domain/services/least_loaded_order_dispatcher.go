@@ -0,0 +1,44 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"time"
+)
+
+var _ OrderDispatcher = &leastLoadedOrderDispatcher{}
+
+// leastLoadedOrderDispatcher назначает заказ курьеру, который везет меньше всего заказов.
+// При равной загрузке выбирается курьер, который быстрее доберется до заказа
+type leastLoadedOrderDispatcher struct{}
+
+func NewLeastLoadedOrderDispatcher() OrderDispatcher {
+	return &leastLoadedOrderDispatcher{}
+}
+
+func (od leastLoadedOrderDispatcher) Dispatch(o *order.Order, couriers []*courier.Courier) (*courier.Courier, error) {
+	return dispatch(o, couriers, od)
+}
+
+func (od leastLoadedOrderDispatcher) selectCourier(o *order.Order, candidates []*courier.Courier) (*courier.Courier, error) {
+	var (
+		bestCourier *courier.Courier
+		bestTime    time.Duration
+	)
+
+	for _, c := range candidates {
+		t, err := c.EstimateTimeToDeliver(o)
+		if err != nil {
+			return nil, err
+		}
+
+		if bestCourier == nil ||
+			c.OrdersCount() < bestCourier.OrdersCount() ||
+			(c.OrdersCount() == bestCourier.OrdersCount() && t < bestTime) {
+			bestCourier = c
+			bestTime = t
+		}
+	}
+
+	return bestCourier, nil
+}
@@ -0,0 +1,84 @@
+package services_test
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/tests"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeastLoadedOrderDispatcher_Dispatch(t *testing.T) {
+	testCases := []struct {
+		name        string
+		couriers    func() []*courier.Courier
+		orderAt     kernel.Location
+		wantCourier int
+	}{
+		{
+			name: "Prefers courier without orders over nearer loaded one",
+			couriers: func() []*courier.Courier {
+				return []*courier.Courier{
+					loadedCourier(t, "Bob", 1, tests.CreateLocation(1, 1), 1),
+					tests.CreateCourier("Alice", 1, tests.CreateLocation(8, 8)),
+				}
+			},
+			orderAt:     tests.CreateLocation(1, 1),
+			wantCourier: 1,
+		},
+		{
+			name: "Prefers nearest courier when load is equal",
+			couriers: func() []*courier.Courier {
+				return []*courier.Courier{
+					loadedCourier(t, "Bob", 1, tests.CreateLocation(8, 8), 1),
+					loadedCourier(t, "Alice", 1, tests.CreateLocation(2, 2), 1),
+				}
+			},
+			orderAt:     tests.CreateLocation(1, 1),
+			wantCourier: 1,
+		},
+		{
+			name: "Prefers courier with fewer orders",
+			couriers: func() []*courier.Courier {
+				return []*courier.Courier{
+					loadedCourier(t, "Bob", 1, tests.CreateLocation(1, 1), 2),
+					loadedCourier(t, "Alice", 1, tests.CreateLocation(5, 5), 1),
+				}
+			},
+			orderAt:     tests.CreateLocation(1, 1),
+			wantCourier: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			couriers := tc.couriers()
+			o := tests.CreateOrder(uuid.New(), tc.orderAt, 1)
+
+			got, err := services.NewLeastLoadedOrderDispatcher().Dispatch(o, couriers)
+			require.NoError(t, err)
+			assert.Equal(t, couriers[tc.wantCourier], got)
+			assert.Equal(t, got.Id(), *o.CourierID())
+		})
+	}
+}
+
+// loadedCourier создает курьера с тремя местами хранения, в которых лежит ordersCount заказов
+func loadedCourier(t *testing.T, name string, speed int, location kernel.Location, ordersCount int) *courier.Courier {
+	t.Helper()
+
+	c := tests.CreateCourier(name, speed, location)
+	require.NoError(t, c.AddStoragePlace("Trunk", 10, 0))
+	require.NoError(t, c.AddStoragePlace("Box", 10, 0))
+
+	for range ordersCount {
+		o := tests.CreateOrder(uuid.New(), location, 1)
+		require.NoError(t, c.TakeOrder(o))
+	}
+
+	return c
+}
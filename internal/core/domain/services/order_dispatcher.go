@@ -6,6 +6,7 @@ import (
 	"delivery/internal/pkg/errs"
 	"errors"
 	"math"
+	"time"
 )
 
 var (
@@ -17,8 +18,14 @@ type OrderDispatcher interface {
 	Dispatch(order *order.Order, couriers []*courier.Courier) (*courier.Courier, error)
 }
 
+// courierSelector выбирает курьера среди тех, кто может взять заказ
+type courierSelector interface {
+	selectCourier(o *order.Order, candidates []*courier.Courier) (*courier.Courier, error)
+}
+
 var _ OrderDispatcher = &orderDispatcher{}
 
+// orderDispatcher назначает заказ курьеру, который быстрее всех доберется до заказа
 type orderDispatcher struct{}
 
 func NewOrderDispatcher() OrderDispatcher {
@@ -26,6 +33,34 @@ func NewOrderDispatcher() OrderDispatcher {
 }
 
 func (od orderDispatcher) Dispatch(o *order.Order, couriers []*courier.Courier) (*courier.Courier, error) {
+	return dispatch(o, couriers, od)
+}
+
+func (od orderDispatcher) selectCourier(o *order.Order, candidates []*courier.Courier) (*courier.Courier, error) {
+	var (
+		bestCourier *courier.Courier
+		minTime     = time.Duration(math.MaxInt64)
+	)
+
+	for _, c := range candidates {
+		t, err := c.EstimateTimeToDeliver(o)
+		if err != nil {
+			return nil, err
+		}
+
+		if t < minTime {
+			minTime = t
+
+			bestCourier = c
+		}
+	}
+
+	return bestCourier, nil
+}
+
+// dispatch проверяет заказ, отбирает курьеров, способных его взять,
+// и назначает заказ курьеру, выбранному стратегией
+func dispatch(o *order.Order, couriers []*courier.Courier, selector courierSelector) (*courier.Courier, error) {
 	if o == nil {
 		return nil, errs.NewValueIsRequiredError("order")
 	}
@@ -38,7 +73,16 @@ func (od orderDispatcher) Dispatch(o *order.Order, couriers []*courier.Courier)
 		return nil, ErrOrderIsAlreadyAssigned
 	}
 
-	bestCourier, err := od.selectBestCourier(o, couriers)
+	candidates, err := suitableCouriers(o, couriers)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrNoSuitableCourier
+	}
+
+	bestCourier, err := selector.selectCourier(o, candidates)
 	if err != nil {
 		return nil, err
 	}
@@ -60,13 +104,17 @@ func (od orderDispatcher) Dispatch(o *order.Order, couriers []*courier.Courier)
 	return bestCourier, nil
 }
 
-func (od orderDispatcher) selectBestCourier(o *order.Order, couriers []*courier.Courier) (*courier.Courier, error) {
-	var (
-		bestCourier *courier.Courier
-		minTime     = math.MaxFloat64
-	)
+// suitableCouriers отбирает курьеров на смене, чей транспорт подходит для заказа, у которых есть
+// для него место и которые успеют доставить его к концу обещанного интервала
+func suitableCouriers(o *order.Order, couriers []*courier.Courier) ([]*courier.Courier, error) {
+	now := time.Now()
 
+	candidates := make([]*courier.Courier, 0, len(couriers))
 	for _, c := range couriers {
+		if !c.IsOnShift() || !c.CanCarry(o) {
+			continue
+		}
+
 		canTake, err := c.CanTakeOrder(o)
 		if err != nil {
 			return nil, err
@@ -76,17 +124,30 @@ func (od orderDispatcher) selectBestCourier(o *order.Order, couriers []*courier.
 			continue
 		}
 
-		t, err := c.CalculateTimeToLocation(o.Location())
+		meets, err := meetsDeliveryWindow(c, o, now)
 		if err != nil {
 			return nil, err
 		}
 
-		if t < minTime {
-			minTime = t
-
-			bestCourier = c
+		if meets {
+			candidates = append(candidates, c)
 		}
 	}
 
-	return bestCourier, nil
+	return candidates, nil
+}
+
+// meetsDeliveryWindow проверяет, успеет ли курьер, выехав в момент now, доставить заказ до конца интервала
+func meetsDeliveryWindow(c *courier.Courier, o *order.Order, now time.Time) (bool, error) {
+	window := o.DeliveryWindow()
+	if !window.IsValid() {
+		return true, nil
+	}
+
+	eta, err := c.EstimateTimeToDeliver(o)
+	if err != nil {
+		return false, err
+	}
+
+	return !window.IsMissedBy(now.Add(eta)), nil
 }
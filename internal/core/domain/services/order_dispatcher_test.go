@@ -2,10 +2,12 @@ package services_test
 
 import (
 	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/pkg/tests"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,7 +20,7 @@ func TestOrderDispatcher_Dispatch_Success(t *testing.T) {
 		tests.CreateCourier("Alice", 2, tests.CreateLocation(3, 3)),
 	}
 
-	o, err := order.NewOrder(uuid.New(), tests.CreateLocation(1, 1), 5)
+	o, err := order.NewOrder(uuid.New(), tests.CreateLocation(1, 1), tests.CreateLocation(1, 1), 5)
 	require.NoError(t, err)
 
 	dispatcher := services.NewOrderDispatcher()
@@ -32,6 +34,83 @@ func TestOrderDispatcher_Dispatch_Success(t *testing.T) {
 	assert.Equal(t, couriers[0].Id(), *o.CourierID())
 }
 
+func TestOrderDispatcher_Dispatch_AccountsForPickup(t *testing.T) {
+	// Bob ближе к месту доставки, но Alice ближе к месту забора
+	couriers := []*courier.Courier{
+		tests.CreateCourier("Bob", 1, tests.CreateLocation(10, 10)),
+		tests.CreateCourier("Alice", 1, tests.CreateLocation(1, 2)),
+	}
+
+	o, err := order.NewOrder(uuid.New(), tests.CreateLocation(1, 1), tests.CreateLocation(9, 9), 5)
+	require.NoError(t, err)
+
+	dispatcher := services.NewOrderDispatcher()
+
+	got, err := dispatcher.Dispatch(o, couriers)
+	require.NoError(t, err)
+	assert.Equal(t, couriers[1], got)
+}
+
+func TestOrderDispatcher_Dispatch_SkipsCouriersNotOnShift(t *testing.T) {
+	offShift, err := courier.NewCourier("Bob", 10, tests.CreateLocation(1, 1))
+	require.NoError(t, err)
+
+	onBreak := tests.CreateCourier("Carol", 10, tests.CreateLocation(1, 1))
+	require.NoError(t, onBreak.StartBreak())
+
+	onShift := tests.CreateCourier("Alice", 1, tests.CreateLocation(10, 10))
+
+	o := tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 5)
+
+	got, err := services.NewOrderDispatcher().Dispatch(o, []*courier.Courier{offShift, onBreak, onShift})
+	require.NoError(t, err)
+	assert.Equal(t, onShift, got)
+
+	_, err = services.NewOrderDispatcher().Dispatch(
+		tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 5), []*courier.Courier{offShift, onBreak})
+	assert.ErrorIs(t, err, services.ErrNoSuitableCourier)
+}
+
+func TestOrderDispatcher_Dispatch_RespectsVehicleRestrictions(t *testing.T) {
+	// пешему курьеру хватает места, но такой объем можно везти только на машине
+	walker := tests.CreateCourier("Bob", 10, tests.CreateLocation(1, 1))
+	require.NoError(t, walker.AddStoragePlace("Trolley", 50, 0))
+
+	car := tests.CreateCourierWithVehicle("Alice", courier.VehicleCar, 1, tests.CreateLocation(10, 10))
+
+	o := tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 40)
+
+	got, err := services.NewOrderDispatcher().Dispatch(o, []*courier.Courier{walker, car})
+	require.NoError(t, err)
+	assert.Equal(t, car, got)
+
+	_, err = services.NewOrderDispatcher().Dispatch(
+		tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 40), []*courier.Courier{walker})
+	assert.ErrorIs(t, err, services.ErrNoSuitableCourier)
+}
+
+func TestOrderDispatcher_Dispatch_RespectsDeliveryWindow(t *testing.T) {
+	createOrder := func() *order.Order {
+		o := tests.CreateOrder(uuid.New(), tests.CreateLocation(10, 10), 5)
+		window, err := order.NewDeliveryWindow(time.Now(), time.Now().Add(10*kernel.StepDuration))
+		require.NoError(t, err)
+		require.NoError(t, o.PromiseDeliveryWindow(window))
+
+		return o
+	}
+
+	// дальнему курьеру нужно 18 шагов, к концу интервала он не успевает
+	far := tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1))
+	near := tests.CreateCourier("Alice", 1, tests.CreateLocation(8, 8))
+
+	_, err := services.NewOrderDispatcher().Dispatch(createOrder(), []*courier.Courier{far})
+	assert.ErrorIs(t, err, services.ErrNoSuitableCourier)
+
+	got, err := services.NewOrderDispatcher().Dispatch(createOrder(), []*courier.Courier{far, near})
+	require.NoError(t, err)
+	assert.Equal(t, near, got)
+}
+
 func TestOrderDispatcher_Dispatch_Errors(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -82,6 +161,8 @@ func TestOrderDispatcher_Dispatch_OrderCompleted(t *testing.T) {
 	c := tests.CreateCourier("Bob", 10, tests.CreateLocation(1, 1))
 	err := o.Assign(c.Id())
 	require.NoError(t, err)
+	err = o.PickUp()
+	require.NoError(t, err)
 	err = o.Complete()
 	require.NoError(t, err)
 
@@ -94,7 +175,7 @@ func TestOrderDispatcher_Dispatch_OrderCompleted(t *testing.T) {
 
 func testsCreateOrderWithLocationAndWeight(id uuid.UUID, x, y, weight int) *order.Order {
 	loc := tests.CreateLocation(x, y)
-	o, err := order.NewOrder(id, loc, weight)
+	o, err := order.NewOrder(id, loc, loc, weight)
 	if err != nil {
 		panic(err)
 	}
@@ -106,6 +187,9 @@ func Test_OrderDispatcher_Errors(t *testing.T) {
 	c := tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1))
 	o := tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 15)
 	err := o.Assign(tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1)).Id())
+	assert.Nil(t, err)
+	err = o.PickUp()
+	assert.Nil(t, err)
 	err = o.Complete()
 	assert.Nil(t, err)
 	c, err = od.Dispatch(o, []*courier.Courier{
@@ -115,3 +199,24 @@ func Test_OrderDispatcher_Errors(t *testing.T) {
 	assert.Nil(t, c)
 	assert.ErrorIs(t, services.ErrOrderIsAlreadyAssigned, err)
 }
+
+func TestOrderDispatcher_Dispatch_PartiallyLoadedCourier(t *testing.T) {
+	loaded := tests.CreateCourierWithVehicle("Bob", courier.VehicleCar, 1, tests.CreateLocation(1, 1))
+
+	first := tests.CreateOrder(uuid.New(), tests.CreateLocation(2, 2), 8)
+	require.NoError(t, loaded.TakeOrder(first))
+	require.NoError(t, first.Assign(loaded.Id()))
+
+	couriers := []*courier.Courier{
+		loaded,
+		tests.CreateCourier("Alice", 1, tests.CreateLocation(10, 10)),
+	}
+
+	second := tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 2), 30)
+
+	got, err := services.NewOrderDispatcher().Dispatch(second, couriers)
+	require.NoError(t, err)
+	assert.Equal(t, loaded, got)
+	assert.True(t, loaded.HasOrder(first.ID()))
+	assert.True(t, loaded.HasOrder(second.ID()))
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+var _ OrderDispatcher = &roundRobinOrderDispatcher{}
+
+// roundRobinOrderDispatcher назначает заказы курьерам по очереди в порядке их идентификаторов,
+// чтобы заказы распределялись равномерно независимо от расстояния
+type roundRobinOrderDispatcher struct {
+	mu            sync.Mutex
+	lastCourierID uuid.UUID
+}
+
+func NewRoundRobinOrderDispatcher() OrderDispatcher {
+	return &roundRobinOrderDispatcher{}
+}
+
+func (od *roundRobinOrderDispatcher) Dispatch(o *order.Order, couriers []*courier.Courier) (*courier.Courier, error) {
+	od.mu.Lock()
+	defer od.mu.Unlock()
+
+	bestCourier, err := dispatch(o, couriers, od)
+	if err != nil {
+		return nil, err
+	}
+
+	od.lastCourierID = bestCourier.Id()
+
+	return bestCourier, nil
+}
+
+func (od *roundRobinOrderDispatcher) selectCourier(_ *order.Order, candidates []*courier.Courier) (*courier.Courier, error) {
+	sorted := slices.Clone(candidates)
+	slices.SortFunc(sorted, func(a, b *courier.Courier) int {
+		return compareIDs(a.Id(), b.Id())
+	})
+
+	// Следующий по порядку после последнего назначенного курьера,
+	// если такого нет - очередь начинается сначала
+	for _, c := range sorted {
+		if compareIDs(c.Id(), od.lastCourierID) > 0 {
+			return c, nil
+		}
+	}
+
+	return sorted[0], nil
+}
+
+func compareIDs(a, b uuid.UUID) int {
+	return slices.Compare(a[:], b[:])
+}
@@ -0,0 +1,90 @@
+package services_test
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/tests"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundRobinOrderDispatcher_Dispatch(t *testing.T) {
+	testCases := []struct {
+		name          string
+		couriersCount int
+		ordersCount   int
+	}{
+		{
+			name:          "One courier",
+			couriersCount: 1,
+			ordersCount:   3,
+		},
+		{
+			name:          "Orders equal to couriers",
+			couriersCount: 3,
+			ordersCount:   3,
+		},
+		{
+			name:          "More orders than couriers",
+			couriersCount: 3,
+			ordersCount:   7,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			couriers := make([]*courier.Courier, 0, tc.couriersCount)
+			for range tc.couriersCount {
+				c := tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1))
+				for range tc.ordersCount {
+					require.NoError(t, c.AddStoragePlace("Box", 10, 0))
+				}
+				couriers = append(couriers, c)
+			}
+
+			sorted := slices.Clone(couriers)
+			slices.SortFunc(sorted, func(a, b *courier.Courier) int {
+				return strings.Compare(a.Id().String(), b.Id().String())
+			})
+
+			dispatcher := services.NewRoundRobinOrderDispatcher()
+			for i := range tc.ordersCount {
+				o := tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 1)
+
+				got, err := dispatcher.Dispatch(o, couriers)
+				require.NoError(t, err)
+				assert.Equal(t, sorted[i%len(sorted)], got)
+			}
+		})
+	}
+}
+
+func TestRoundRobinOrderDispatcher_SkipsCourierWithoutCapacity(t *testing.T) {
+	first := tests.CreateCourier("Bob", 1, tests.CreateLocation(1, 1))
+	second := tests.CreateCourier("Alice", 1, tests.CreateLocation(1, 1))
+	couriers := []*courier.Courier{first, second}
+
+	dispatcher := services.NewRoundRobinOrderDispatcher()
+
+	got, err := dispatcher.Dispatch(tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 1), couriers)
+	require.NoError(t, err)
+
+	// У первого назначенного курьера больше нет мест, поэтому следующие заказы
+	// достаются другому курьеру, а затем свободных курьеров не остается
+	other := first
+	if got == first {
+		other = second
+	}
+
+	next, err := dispatcher.Dispatch(tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 1), couriers)
+	require.NoError(t, err)
+	assert.Equal(t, other, next)
+
+	_, err = dispatcher.Dispatch(tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 1), couriers)
+	assert.ErrorIs(t, err, services.ErrNoSuitableCourier)
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"math"
+)
+
+// DispatchWeights задает вклад каждого критерия в оценку курьера
+type DispatchWeights struct {
+	Distance float64
+	Speed    float64
+	Capacity float64
+}
+
+func DefaultDispatchWeights() DispatchWeights {
+	return DispatchWeights{
+		Distance: 0.5,
+		Speed:    0.2,
+		Capacity: 0.3,
+	}
+}
+
+var _ OrderDispatcher = &weightedOrderDispatcher{}
+
+// weightedOrderDispatcher назначает заказ курьеру с наименьшей взвешенной оценкой.
+// Критерии нормируются относительно лучших значений среди кандидатов:
+// чем ближе курьер, чем он быстрее и чем больше у него свободного объема, тем ниже оценка
+type weightedOrderDispatcher struct {
+	weights DispatchWeights
+}
+
+func NewWeightedOrderDispatcher(weights DispatchWeights) (OrderDispatcher, error) {
+	if weights.Distance < 0 {
+		return nil, errs.NewValueIsInvalidError("weights.Distance")
+	}
+
+	if weights.Speed < 0 {
+		return nil, errs.NewValueIsInvalidError("weights.Speed")
+	}
+
+	if weights.Capacity < 0 {
+		return nil, errs.NewValueIsInvalidError("weights.Capacity")
+	}
+
+	if weights.Distance+weights.Speed+weights.Capacity == 0 {
+		return nil, errs.NewValueIsInvalidError("weights")
+	}
+
+	return &weightedOrderDispatcher{weights: weights}, nil
+}
+
+func (od weightedOrderDispatcher) Dispatch(o *order.Order, couriers []*courier.Courier) (*courier.Courier, error) {
+	return dispatch(o, couriers, od)
+}
+
+func (od weightedOrderDispatcher) selectCourier(o *order.Order, candidates []*courier.Courier) (*courier.Courier, error) {
+	// Курьеры, от которых заказ недостижим, не участвуют в нормировке:
+	// бесконечное расстояние превратило бы оценки остальных в NaN
+	reachable := make([]*courier.Courier, 0, len(candidates))
+	distances := make(map[*courier.Courier]float64, len(candidates))
+	for _, c := range candidates {
+		distance := deliveryDistance(c, o)
+		if math.IsInf(distance, 0) || math.IsNaN(distance) {
+			continue
+		}
+
+		reachable = append(reachable, c)
+		distances[c] = distance
+	}
+
+	var maxDistance float64
+	var maxSpeed, maxCapacity int
+	for _, c := range reachable {
+		maxDistance = max(maxDistance, distances[c])
+		maxSpeed = max(maxSpeed, c.Speed())
+		maxCapacity = max(maxCapacity, c.FreeVolume())
+	}
+
+	var (
+		bestCourier *courier.Courier
+		minScore    = math.MaxFloat64
+	)
+
+	for _, c := range reachable {
+		score := od.weights.Distance*ratio(distances[c], maxDistance) +
+			od.weights.Speed*(1-ratio(c.Speed(), maxSpeed)) +
+			od.weights.Capacity*(1-ratio(c.FreeVolume(), maxCapacity))
+
+		if score < minScore {
+			minScore = score
+			bestCourier = c
+		}
+	}
+
+	return bestCourier, nil
+}
+
+// deliveryDistance - путь курьера до места забора заказа и от него до места доставки
+func deliveryDistance(c *courier.Courier, o *order.Order) float64 {
+	return kernel.CurrentDistanceMetric().Distance(c.Location(), o.PickupLocation()) + o.DeliveryDistance()
+}
+
+func ratio[T int | float64](value, maxValue T) float64 {
+	if maxValue == 0 {
+		return 0
+	}
+
+	return float64(value) / float64(maxValue)
+}
@@ -0,0 +1,168 @@
+package services_test
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tests"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedOrderDispatcher_Dispatch(t *testing.T) {
+	testCases := []struct {
+		name        string
+		weights     services.DispatchWeights
+		couriers    func() []*courier.Courier
+		wantCourier int
+	}{
+		{
+			name:    "Distance only prefers nearest courier",
+			weights: services.DispatchWeights{Distance: 1},
+			couriers: func() []*courier.Courier {
+				return []*courier.Courier{
+					tests.CreateCourier("Bob", 1, tests.CreateLocation(9, 9)),
+					tests.CreateCourier("Alice", 1, tests.CreateLocation(2, 2)),
+				}
+			},
+			wantCourier: 1,
+		},
+		{
+			name:    "Speed only prefers fastest courier",
+			weights: services.DispatchWeights{Speed: 1},
+			couriers: func() []*courier.Courier {
+				return []*courier.Courier{
+					tests.CreateCourier("Bob", 3, tests.CreateLocation(9, 9)),
+					tests.CreateCourier("Alice", 1, tests.CreateLocation(2, 2)),
+				}
+			},
+			wantCourier: 0,
+		},
+		{
+			name:    "Capacity only prefers courier with most free volume",
+			weights: services.DispatchWeights{Capacity: 1},
+			couriers: func() []*courier.Courier {
+				bob := tests.CreateCourier("Bob", 1, tests.CreateLocation(2, 2))
+				alice := tests.CreateCourier("Alice", 1, tests.CreateLocation(9, 9))
+				require.NoError(t, alice.AddStoragePlace("Trunk", 40, 0))
+				return []*courier.Courier{bob, alice}
+			},
+			wantCourier: 1,
+		},
+		{
+			name:    "Default weights trade small distance for large capacity",
+			weights: services.DefaultDispatchWeights(),
+			couriers: func() []*courier.Courier {
+				bob := tests.CreateCourier("Bob", 1, tests.CreateLocation(2, 2))
+				alice := tests.CreateCourier("Alice", 1, tests.CreateLocation(2, 3))
+				require.NoError(t, alice.AddStoragePlace("Trunk", 40, 0))
+				return []*courier.Courier{bob, alice}
+			},
+			wantCourier: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			couriers := tc.couriers()
+			o := tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 1)
+
+			dispatcher, err := services.NewWeightedOrderDispatcher(tc.weights)
+			require.NoError(t, err)
+
+			got, err := dispatcher.Dispatch(o, couriers)
+			require.NoError(t, err)
+			assert.Equal(t, couriers[tc.wantCourier], got)
+		})
+	}
+}
+
+func TestNewWeightedOrderDispatcher_Errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		weights services.DispatchWeights
+		wantErr error
+	}{
+		{
+			name:    "Negative distance weight",
+			weights: services.DispatchWeights{Distance: -1, Speed: 1},
+			wantErr: errs.NewValueIsInvalidError("weights.Distance"),
+		},
+		{
+			name:    "Negative speed weight",
+			weights: services.DispatchWeights{Speed: -1, Capacity: 1},
+			wantErr: errs.NewValueIsInvalidError("weights.Speed"),
+		},
+		{
+			name:    "Negative capacity weight",
+			weights: services.DispatchWeights{Distance: 1, Capacity: -1},
+			wantErr: errs.NewValueIsInvalidError("weights.Capacity"),
+		},
+		{
+			name:    "All weights are zero",
+			weights: services.DispatchWeights{},
+			wantErr: errs.NewValueIsInvalidError("weights"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := services.NewWeightedOrderDispatcher(tc.weights)
+			require.Error(t, err)
+			assert.Equal(t, tc.wantErr.Error(), err.Error())
+			assert.Nil(t, got)
+		})
+	}
+}
+
+func TestWeightedOrderDispatcher_SkipsUnreachableCourier(t *testing.T) {
+	unreachable := tests.CreateLocation(5, 5)
+	setUnreachableFrom(t, unreachable)
+
+	couriers := []*courier.Courier{
+		tests.CreateCourier("Carol", 1, unreachable),
+		tests.CreateCourier("Bob", 1, tests.CreateLocation(9, 9)),
+		tests.CreateCourier("Alice", 1, tests.CreateLocation(2, 2)),
+	}
+	o := tests.CreateOrder(uuid.New(), tests.CreateLocation(1, 1), 1)
+
+	dispatcher, err := services.NewWeightedOrderDispatcher(services.DispatchWeights{Distance: 1})
+	require.NoError(t, err)
+
+	got, err := dispatcher.Dispatch(o, couriers[:1])
+	assert.ErrorIs(t, err, services.ErrNoSuitableCourier)
+	assert.Nil(t, got)
+
+	got, err = dispatcher.Dispatch(o, couriers)
+	require.NoError(t, err)
+	assert.Equal(t, couriers[2], got)
+}
+
+// unreachableMetric - манхэттенская метрика, в которой из точки from никуда нельзя добраться
+type unreachableMetric struct {
+	kernel.DistanceMetric
+	from kernel.Location
+}
+
+func (m unreachableMetric) Distance(from, to kernel.Location) float64 {
+	if from.Equals(m.from) {
+		return math.Inf(1)
+	}
+
+	return m.DistanceMetric.Distance(from, to)
+}
+
+func setUnreachableFrom(t *testing.T, from kernel.Location) {
+	t.Helper()
+
+	previous := kernel.CurrentDistanceMetric()
+	require.NoError(t, kernel.SetDistanceMetric(unreachableMetric{DistanceMetric: kernel.NewManhattanMetric(), from: from}))
+	t.Cleanup(func() {
+		require.NoError(t, kernel.SetDistanceMetric(previous))
+	})
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"delivery/internal/core/domain/model/order"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrderHistoryRepository хранит историю смены статусов заказов
+type OrderHistoryRepository interface {
+	// Add записывает смену статуса. changeID - идентификатор доменного события,
+	// повторная запись той же смены статуса игнорируется
+	Add(ctx context.Context, changeID uuid.UUID, orderID uuid.UUID, status order.Status, changedAt time.Time) error
+}
@@ -12,5 +12,8 @@ type OrderRepository interface {
 	Update(ctx context.Context, aggregate *order.Order) error
 	Get(ctx context.Context, ID uuid.UUID) (*order.Order, error)
 	GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error)
+	GetMostUrgentInCreatedStatus(ctx context.Context, limit int) ([]*order.Order, error)
+	GetAllInCreatedStatus(ctx context.Context) ([]*order.Order, error)
 	GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error)
+	GetAllInDelivery(ctx context.Context) ([]*order.Order, error)
 }
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+	"delivery/internal/pkg/outbox"
+)
+
+type OutboxRepository interface {
+	Add(ctx context.Context, message *outbox.Message) error
+	Update(ctx context.Context, message *outbox.Message) error
+	GetNotPublishedMessages(ctx context.Context, limit int) ([]*outbox.Message, error)
+}
@@ -9,5 +9,7 @@ type UnitOfWork interface {
 	Commit(ctx context.Context) error
 	CourierRepository() CourierRepository
 	OrderRepository() OrderRepository
+	OrderHistoryRepository() OrderHistoryRepository
+	OutboxRepository() OutboxRepository
 	RollbackUnlessCommitted(ctx context.Context)
 }
@@ -0,0 +1,16 @@
+package ports
+
+import "context"
+
+type unitOfWorkContextKey struct{}
+
+// ContextWithUnitOfWork передаёт текущую единицу работы обработчикам доменных событий,
+// вызываемым до фиксации транзакции. Через неё они работают в той же транзакции.
+func ContextWithUnitOfWork(ctx context.Context, uow UnitOfWork) context.Context {
+	return context.WithValue(ctx, unitOfWorkContextKey{}, uow)
+}
+
+func UnitOfWorkFromContext(ctx context.Context) (UnitOfWork, bool) {
+	uow, ok := ctx.Value(unitOfWorkContextKey{}).(UnitOfWork)
+	return uow, ok
+}
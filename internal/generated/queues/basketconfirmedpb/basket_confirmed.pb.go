@@ -0,0 +1,471 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v6.31.1
+// source: api/proto/basket_confirmed.proto
+
+package basketconfirmedpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Basket confirmed by the customer
+type BasketConfirmedIntegrationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BasketId       string          `protobuf:"bytes,1,opt,name=BasketId,proto3" json:"BasketId,omitempty"`
+	Address        *Address        `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	Items          []*Item         `protobuf:"bytes,3,rep,name=Items,proto3" json:"Items,omitempty"`
+	DeliveryPeriod *DeliveryPeriod `protobuf:"bytes,4,opt,name=DeliveryPeriod,proto3" json:"DeliveryPeriod,omitempty"`
+	Volume         int32           `protobuf:"varint,5,opt,name=Volume,proto3" json:"Volume,omitempty"`
+}
+
+func (x *BasketConfirmedIntegrationEvent) Reset() {
+	*x = BasketConfirmedIntegrationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_basket_confirmed_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BasketConfirmedIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketConfirmedIntegrationEvent) ProtoMessage() {}
+
+func (x *BasketConfirmedIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_basket_confirmed_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasketConfirmedIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*BasketConfirmedIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_basket_confirmed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BasketConfirmedIntegrationEvent) GetBasketId() string {
+	if x != nil {
+		return x.BasketId
+	}
+	return ""
+}
+
+func (x *BasketConfirmedIntegrationEvent) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *BasketConfirmedIntegrationEvent) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BasketConfirmedIntegrationEvent) GetDeliveryPeriod() *DeliveryPeriod {
+	if x != nil {
+		return x.DeliveryPeriod
+	}
+	return nil
+}
+
+func (x *BasketConfirmedIntegrationEvent) GetVolume() int32 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+// Delivery address
+type Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Country   string `protobuf:"bytes,1,opt,name=Country,proto3" json:"Country,omitempty"`
+	City      string `protobuf:"bytes,2,opt,name=City,proto3" json:"City,omitempty"`
+	Street    string `protobuf:"bytes,3,opt,name=Street,proto3" json:"Street,omitempty"`
+	House     string `protobuf:"bytes,4,opt,name=House,proto3" json:"House,omitempty"`
+	Apartment string `protobuf:"bytes,5,opt,name=Apartment,proto3" json:"Apartment,omitempty"`
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_basket_confirmed_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_basket_confirmed_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_api_proto_basket_confirmed_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetHouse() string {
+	if x != nil {
+		return x.House
+	}
+	return ""
+}
+
+func (x *Address) GetApartment() string {
+	if x != nil {
+		return x.Apartment
+	}
+	return ""
+}
+
+// Basket item
+type Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string  `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	GoodId   string  `protobuf:"bytes,2,opt,name=GoodId,proto3" json:"GoodId,omitempty"`
+	Title    string  `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
+	Price    float64 `protobuf:"fixed64,4,opt,name=Price,proto3" json:"Price,omitempty"`
+	Quantity int32   `protobuf:"varint,5,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_basket_confirmed_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_basket_confirmed_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_api_proto_basket_confirmed_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Item) GetGoodId() string {
+	if x != nil {
+		return x.GoodId
+	}
+	return ""
+}
+
+func (x *Item) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Item) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Item) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Desired delivery period
+type DeliveryPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From int32 `protobuf:"varint,1,opt,name=From,proto3" json:"From,omitempty"`
+	To   int32 `protobuf:"varint,2,opt,name=To,proto3" json:"To,omitempty"`
+}
+
+func (x *DeliveryPeriod) Reset() {
+	*x = DeliveryPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_basket_confirmed_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliveryPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryPeriod) ProtoMessage() {}
+
+func (x *DeliveryPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_basket_confirmed_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryPeriod.ProtoReflect.Descriptor instead.
+func (*DeliveryPeriod) Descriptor() ([]byte, []int) {
+	return file_api_proto_basket_confirmed_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeliveryPeriod) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DeliveryPeriod) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+var File_api_proto_basket_confirmed_proto protoreflect.FileDescriptor
+
+var file_api_proto_basket_confirmed_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x18, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x70, 0x62, 0x22, 0x9a, 0x02, 0x0a,
+	0x1f, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x70, 0x62, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x50, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x52, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x07, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43,
+	0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x76, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x6f, 0x6f, 0x64, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x34, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x54, 0x6f, 0x42, 0x1a, 0x5a,
+	0x18, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_api_proto_basket_confirmed_proto_rawDescOnce sync.Once
+	file_api_proto_basket_confirmed_proto_rawDescData = file_api_proto_basket_confirmed_proto_rawDesc
+)
+
+func file_api_proto_basket_confirmed_proto_rawDescGZIP() []byte {
+	file_api_proto_basket_confirmed_proto_rawDescOnce.Do(func() {
+		file_api_proto_basket_confirmed_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_proto_basket_confirmed_proto_rawDescData)
+	})
+	return file_api_proto_basket_confirmed_proto_rawDescData
+}
+
+var file_api_proto_basket_confirmed_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_proto_basket_confirmed_proto_goTypes = []interface{}{
+	(*BasketConfirmedIntegrationEvent)(nil), // 0: queues.basketconfirmedpb.BasketConfirmedIntegrationEvent
+	(*Address)(nil),                         // 1: queues.basketconfirmedpb.Address
+	(*Item)(nil),                            // 2: queues.basketconfirmedpb.Item
+	(*DeliveryPeriod)(nil),                  // 3: queues.basketconfirmedpb.DeliveryPeriod
+}
+var file_api_proto_basket_confirmed_proto_depIdxs = []int32{
+	1, // 0: queues.basketconfirmedpb.BasketConfirmedIntegrationEvent.Address:type_name -> queues.basketconfirmedpb.Address
+	2, // 1: queues.basketconfirmedpb.BasketConfirmedIntegrationEvent.Items:type_name -> queues.basketconfirmedpb.Item
+	3, // 2: queues.basketconfirmedpb.BasketConfirmedIntegrationEvent.DeliveryPeriod:type_name -> queues.basketconfirmedpb.DeliveryPeriod
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_basket_confirmed_proto_init() }
+func file_api_proto_basket_confirmed_proto_init() {
+	if File_api_proto_basket_confirmed_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_proto_basket_confirmed_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BasketConfirmedIntegrationEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_basket_confirmed_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_basket_confirmed_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_basket_confirmed_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeliveryPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_proto_basket_confirmed_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_basket_confirmed_proto_goTypes,
+		DependencyIndexes: file_api_proto_basket_confirmed_proto_depIdxs,
+		MessageInfos:      file_api_proto_basket_confirmed_proto_msgTypes,
+	}.Build()
+	File_api_proto_basket_confirmed_proto = out.File
+	file_api_proto_basket_confirmed_proto_rawDesc = nil
+	file_api_proto_basket_confirmed_proto_goTypes = nil
+	file_api_proto_basket_confirmed_proto_depIdxs = nil
+}
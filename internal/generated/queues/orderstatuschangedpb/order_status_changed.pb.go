@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v6.31.1
+// source: api/proto/order_status_changed.proto
+
+package orderstatuschangedpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Order status
+type OrderStatus int32
+
+const (
+	OrderStatus_None      OrderStatus = 0
+	OrderStatus_Created   OrderStatus = 1
+	OrderStatus_Assigned  OrderStatus = 2
+	OrderStatus_Completed OrderStatus = 3
+	OrderStatus_Canceled  OrderStatus = 4
+	OrderStatus_PickedUp  OrderStatus = 5
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "None",
+		1: "Created",
+		2: "Assigned",
+		3: "Completed",
+		4: "Canceled",
+		5: "PickedUp",
+	}
+	OrderStatus_value = map[string]int32{
+		"None":      0,
+		"Created":   1,
+		"Assigned":  2,
+		"Completed": 3,
+		"Canceled":  4,
+		"PickedUp":  5,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_order_status_changed_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_order_status_changed_proto_enumTypes[0]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_order_status_changed_proto_rawDescGZIP(), []int{0}
+}
+
+// Order status changed
+type OrderStatusChangedIntegrationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId     string      `protobuf:"bytes,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	OrderStatus OrderStatus `protobuf:"varint,2,opt,name=OrderStatus,proto3,enum=queues.orderstatuschangedpb.OrderStatus" json:"OrderStatus,omitempty"`
+}
+
+func (x *OrderStatusChangedIntegrationEvent) Reset() {
+	*x = OrderStatusChangedIntegrationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_order_status_changed_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderStatusChangedIntegrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusChangedIntegrationEvent) ProtoMessage() {}
+
+func (x *OrderStatusChangedIntegrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_order_status_changed_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusChangedIntegrationEvent.ProtoReflect.Descriptor instead.
+func (*OrderStatusChangedIntegrationEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_order_status_changed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrderStatusChangedIntegrationEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderStatusChangedIntegrationEvent) GetOrderStatus() OrderStatus {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return OrderStatus_None
+}
+
+var File_api_proto_order_status_changed_proto protoreflect.FileDescriptor
+
+var file_api_proto_order_status_changed_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x70, 0x62, 0x22, 0x8a, 0x01, 0x0a, 0x22, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x73, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2a, 0x5d, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x10,
+	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x70, 0x10, 0x05, 0x42,
+	0x1d, 0x5a, 0x1b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_proto_order_status_changed_proto_rawDescOnce sync.Once
+	file_api_proto_order_status_changed_proto_rawDescData = file_api_proto_order_status_changed_proto_rawDesc
+)
+
+func file_api_proto_order_status_changed_proto_rawDescGZIP() []byte {
+	file_api_proto_order_status_changed_proto_rawDescOnce.Do(func() {
+		file_api_proto_order_status_changed_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_proto_order_status_changed_proto_rawDescData)
+	})
+	return file_api_proto_order_status_changed_proto_rawDescData
+}
+
+var file_api_proto_order_status_changed_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_proto_order_status_changed_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_proto_order_status_changed_proto_goTypes = []interface{}{
+	(OrderStatus)(0), // 0: queues.orderstatuschangedpb.OrderStatus
+	(*OrderStatusChangedIntegrationEvent)(nil), // 1: queues.orderstatuschangedpb.OrderStatusChangedIntegrationEvent
+}
+var file_api_proto_order_status_changed_proto_depIdxs = []int32{
+	0, // 0: queues.orderstatuschangedpb.OrderStatusChangedIntegrationEvent.OrderStatus:type_name -> queues.orderstatuschangedpb.OrderStatus
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_order_status_changed_proto_init() }
+func file_api_proto_order_status_changed_proto_init() {
+	if File_api_proto_order_status_changed_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_proto_order_status_changed_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderStatusChangedIntegrationEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_proto_order_status_changed_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_order_status_changed_proto_goTypes,
+		DependencyIndexes: file_api_proto_order_status_changed_proto_depIdxs,
+		EnumInfos:         file_api_proto_order_status_changed_proto_enumTypes,
+		MessageInfos:      file_api_proto_order_status_changed_proto_msgTypes,
+	}.Build()
+	File_api_proto_order_status_changed_proto = out.File
+	file_api_proto_order_status_changed_proto_rawDesc = nil
+	file_api_proto_order_status_changed_proto_goTypes = nil
+	file_api_proto_order_status_changed_proto_depIdxs = nil
+}
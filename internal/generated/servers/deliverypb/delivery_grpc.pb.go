@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v6.31.1
+// source: api/proto/delivery.proto
+
+package deliverypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Delivery_CreateCourier_FullMethodName = "/delivery.Delivery/CreateCourier"
+	Delivery_CreateOrder_FullMethodName   = "/delivery.Delivery/CreateOrder"
+	Delivery_GetCouriers_FullMethodName   = "/delivery.Delivery/GetCouriers"
+	Delivery_GetOrder_FullMethodName      = "/delivery.Delivery/GetOrder"
+	Delivery_WatchCourier_FullMethodName  = "/delivery.Delivery/WatchCourier"
+)
+
+// DeliveryClient is the client API for Delivery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeliveryClient interface {
+	// Create courier
+	CreateCourier(ctx context.Context, in *CreateCourierRequest, opts ...grpc.CallOption) (*CreateCourierReply, error)
+	// Create order. Repeated call with the same id returns the existing order
+	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderReply, error)
+	// Get couriers page
+	GetCouriers(ctx context.Context, in *GetCouriersRequest, opts ...grpc.CallOption) (*GetCouriersReply, error)
+	// Get order with status history
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderReply, error)
+	// Watch courier moves and order status changes. Empty courier_ids - all couriers
+	WatchCourier(ctx context.Context, in *WatchCourierRequest, opts ...grpc.CallOption) (Delivery_WatchCourierClient, error)
+}
+
+type deliveryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeliveryClient(cc grpc.ClientConnInterface) DeliveryClient {
+	return &deliveryClient{cc}
+}
+
+func (c *deliveryClient) CreateCourier(ctx context.Context, in *CreateCourierRequest, opts ...grpc.CallOption) (*CreateCourierReply, error) {
+	out := new(CreateCourierReply)
+	err := c.cc.Invoke(ctx, Delivery_CreateCourier_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryClient) CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderReply, error) {
+	out := new(CreateOrderReply)
+	err := c.cc.Invoke(ctx, Delivery_CreateOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryClient) GetCouriers(ctx context.Context, in *GetCouriersRequest, opts ...grpc.CallOption) (*GetCouriersReply, error) {
+	out := new(GetCouriersReply)
+	err := c.cc.Invoke(ctx, Delivery_GetCouriers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderReply, error) {
+	out := new(GetOrderReply)
+	err := c.cc.Invoke(ctx, Delivery_GetOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryClient) WatchCourier(ctx context.Context, in *WatchCourierRequest, opts ...grpc.CallOption) (Delivery_WatchCourierClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Delivery_ServiceDesc.Streams[0], Delivery_WatchCourier_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deliveryWatchCourierClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Delivery_WatchCourierClient interface {
+	Recv() (*CourierEvent, error)
+	grpc.ClientStream
+}
+
+type deliveryWatchCourierClient struct {
+	grpc.ClientStream
+}
+
+func (x *deliveryWatchCourierClient) Recv() (*CourierEvent, error) {
+	m := new(CourierEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DeliveryServer is the server API for Delivery service.
+// All implementations must embed UnimplementedDeliveryServer
+// for forward compatibility
+type DeliveryServer interface {
+	// Create courier
+	CreateCourier(context.Context, *CreateCourierRequest) (*CreateCourierReply, error)
+	// Create order. Repeated call with the same id returns the existing order
+	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderReply, error)
+	// Get couriers page
+	GetCouriers(context.Context, *GetCouriersRequest) (*GetCouriersReply, error)
+	// Get order with status history
+	GetOrder(context.Context, *GetOrderRequest) (*GetOrderReply, error)
+	// Watch courier moves and order status changes. Empty courier_ids - all couriers
+	WatchCourier(*WatchCourierRequest, Delivery_WatchCourierServer) error
+	mustEmbedUnimplementedDeliveryServer()
+}
+
+// UnimplementedDeliveryServer must be embedded to have forward compatible implementations.
+type UnimplementedDeliveryServer struct {
+}
+
+func (UnimplementedDeliveryServer) CreateCourier(context.Context, *CreateCourierRequest) (*CreateCourierReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourier not implemented")
+}
+func (UnimplementedDeliveryServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (UnimplementedDeliveryServer) GetCouriers(context.Context, *GetCouriersRequest) (*GetCouriersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCouriers not implemented")
+}
+func (UnimplementedDeliveryServer) GetOrder(context.Context, *GetOrderRequest) (*GetOrderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedDeliveryServer) WatchCourier(*WatchCourierRequest, Delivery_WatchCourierServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCourier not implemented")
+}
+func (UnimplementedDeliveryServer) mustEmbedUnimplementedDeliveryServer() {}
+
+// UnsafeDeliveryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeliveryServer will
+// result in compilation errors.
+type UnsafeDeliveryServer interface {
+	mustEmbedUnimplementedDeliveryServer()
+}
+
+func RegisterDeliveryServer(s grpc.ServiceRegistrar, srv DeliveryServer) {
+	s.RegisterService(&Delivery_ServiceDesc, srv)
+}
+
+func _Delivery_CreateCourier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCourierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServer).CreateCourier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Delivery_CreateCourier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServer).CreateCourier(ctx, req.(*CreateCourierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Delivery_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Delivery_CreateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServer).CreateOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Delivery_GetCouriers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouriersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServer).GetCouriers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Delivery_GetCouriers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServer).GetCouriers(ctx, req.(*GetCouriersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Delivery_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Delivery_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Delivery_WatchCourier_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCourierRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeliveryServer).WatchCourier(m, &deliveryWatchCourierServer{stream})
+}
+
+type Delivery_WatchCourierServer interface {
+	Send(*CourierEvent) error
+	grpc.ServerStream
+}
+
+type deliveryWatchCourierServer struct {
+	grpc.ServerStream
+}
+
+func (x *deliveryWatchCourierServer) Send(m *CourierEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Delivery_ServiceDesc is the grpc.ServiceDesc for Delivery service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Delivery_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "delivery.Delivery",
+	HandlerType: (*DeliveryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCourier",
+			Handler:    _Delivery_CreateCourier_Handler,
+		},
+		{
+			MethodName: "CreateOrder",
+			Handler:    _Delivery_CreateOrder_Handler,
+		},
+		{
+			MethodName: "GetCouriers",
+			Handler:    _Delivery_GetCouriers_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _Delivery_GetOrder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCourier",
+			Handler:       _Delivery_WatchCourier_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/proto/delivery.proto",
+}
@@ -10,25 +10,174 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CourierAvailability.
+const (
+	OffShift CourierAvailability = "off_shift"
+	OnBreak  CourierAvailability = "on_break"
+	OnShift  CourierAvailability = "on_shift"
+)
+
+// Defines values for CourierTargetAction.
+const (
+	Delivery CourierTargetAction = "delivery"
+	Pickup   CourierTargetAction = "pickup"
+)
+
+// Defines values for OrderPriority.
+const (
+	High   OrderPriority = "high"
+	Low    OrderPriority = "low"
+	Normal OrderPriority = "normal"
+)
+
+// Defines values for OrderStatus.
+const (
+	Assigned  OrderStatus = "assigned"
+	Canceled  OrderStatus = "canceled"
+	Completed OrderStatus = "completed"
+	Created   OrderStatus = "created"
+	PickedUp  OrderStatus = "picked_up"
+)
+
+// Defines values for VehicleType.
+const (
+	Bicycle VehicleType = "bicycle"
+	Car     VehicleType = "car"
+	Foot    VehicleType = "foot"
+	Scooter VehicleType = "scooter"
+)
+
+// Defines values for OrderSort.
+const (
+	OrderSortId            OrderSort = "id"
+	OrderSortMinusId       OrderSort = "-id"
+	OrderSortMinusPriority OrderSort = "-priority"
+	OrderSortMinusVolume   OrderSort = "-volume"
+	OrderSortPriority      OrderSort = "priority"
+	OrderSortVolume        OrderSort = "volume"
+)
+
+// Defines values for GetCouriersParamsSort.
+const (
+	GetCouriersParamsSortId        GetCouriersParamsSort = "id"
+	GetCouriersParamsSortMinusId   GetCouriersParamsSort = "-id"
+	GetCouriersParamsSortMinusName GetCouriersParamsSort = "-name"
+	GetCouriersParamsSortName      GetCouriersParamsSort = "name"
+)
+
+// Defines values for ListOrdersParamsSort.
+const (
+	ListOrdersParamsSortId            ListOrdersParamsSort = "id"
+	ListOrdersParamsSortMinusId       ListOrdersParamsSort = "-id"
+	ListOrdersParamsSortMinusPriority ListOrdersParamsSort = "-priority"
+	ListOrdersParamsSortMinusVolume   ListOrdersParamsSort = "-volume"
+	ListOrdersParamsSortPriority      ListOrdersParamsSort = "priority"
+	ListOrdersParamsSortVolume        ListOrdersParamsSort = "volume"
+)
+
+// Defines values for GetOrdersParamsSort.
+const (
+	GetOrdersParamsSortId            GetOrdersParamsSort = "id"
+	GetOrdersParamsSortMinusId       GetOrdersParamsSort = "-id"
+	GetOrdersParamsSortMinusPriority GetOrdersParamsSort = "-priority"
+	GetOrdersParamsSortMinusVolume   GetOrdersParamsSort = "-volume"
+	GetOrdersParamsSortPriority      GetOrdersParamsSort = "priority"
+	GetOrdersParamsSortVolume        GetOrdersParamsSort = "volume"
+)
+
 // Courier defines model for Courier.
 type Courier struct {
+	// Availability Доступность курьера
+	Availability CourierAvailability `json:"availability"`
+
+	// Id Идентификатор
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+
+	// Name Имя
+	Name string `json:"name"`
+
+	// VehicleType Транспорт курьера. По умолчанию foot
+	VehicleType VehicleType `json:"vehicleType"`
+}
+
+// CourierAvailability Доступность курьера
+type CourierAvailability string
+
+// CourierDetails defines model for CourierDetails.
+type CourierDetails struct {
+	// Availability Доступность курьера
+	Availability CourierAvailability `json:"availability"`
+
 	// Id Идентификатор
 	Id       openapi_types.UUID `json:"id"`
 	Location Location           `json:"location"`
 
 	// Name Имя
 	Name string `json:"name"`
+
+	// Speed Скорость
+	Speed int `json:"speed"`
+
+	// StoragePlaces Места хранения
+	StoragePlaces []StoragePlace `json:"storagePlaces"`
+
+	// Target Ближайшая точка маршрута курьера. Отсутствует, если у курьера нет заказов
+	Target *CourierTarget `json:"target,omitempty"`
+
+	// VehicleType Транспорт курьера. По умолчанию foot
+	VehicleType VehicleType `json:"vehicleType"`
+}
+
+// CourierTarget Ближайшая точка маршрута курьера. Отсутствует, если у курьера нет заказов
+type CourierTarget struct {
+	// Action Что курьер сделает в точке
+	Action CourierTargetAction `json:"action"`
+
+	// EstimatedArrivalAt Ожидаемое время прибытия. Отсутствует, если до точки нельзя построить маршрут
+	EstimatedArrivalAt *time.Time `json:"estimatedArrivalAt,omitempty"`
+	Location           Location   `json:"location"`
+
+	// OrderId Идентификатор заказа
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+// CourierTargetAction Что курьер сделает в точке
+type CourierTargetAction string
+
+// DeliveryWindow Обещанный интервал доставки
+type DeliveryWindow struct {
+	// From Начало интервала
+	From time.Time `json:"from"`
+
+	// To Конец интервала
+	To time.Time `json:"to"`
+}
+
+// Dimensions Габариты в сантиметрах
+type Dimensions struct {
+	// Height Высота
+	Height int `json:"height"`
+
+	// Length Длина
+	Length int `json:"length"`
+
+	// Width Ширина
+	Width int `json:"width"`
 }
 
 // Error defines model for Error.
@@ -54,34 +203,311 @@ type NewCourier struct {
 	// Name Имя
 	Name string `json:"name"`
 
-	// Speed Скорость
-	Speed int `json:"speed"`
+	// Speed Скорость. По умолчанию определяется транспортом
+	Speed *int `json:"speed,omitempty"`
+
+	// VehicleType Транспорт курьера. По умолчанию foot
+	VehicleType *VehicleType `json:"vehicleType,omitempty"`
+}
+
+// NewOrder defines model for NewOrder.
+type NewOrder struct {
+	// DeliveryWindow Обещанный интервал доставки
+	DeliveryWindow *DeliveryWindow `json:"deliveryWindow,omitempty"`
+
+	// Dimensions Габариты в сантиметрах
+	Dimensions *Dimensions `json:"dimensions,omitempty"`
+
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// Items Состав заказа
+	Items *[]OrderItem `json:"items,omitempty"`
+
+	// Priority Срочность заказа. По умолчанию normal
+	Priority *OrderPriority `json:"priority,omitempty"`
+
+	// Street Улица
+	Street string `json:"street"`
+
+	// Volume Объем
+	Volume int `json:"volume"`
+
+	// Weight Вес в граммах
+	Weight *int `json:"weight,omitempty"`
+}
+
+// NewStoragePlace defines model for NewStoragePlace.
+type NewStoragePlace struct {
+	// MaxWeight Допустимый вес заказа в граммах. Если не задан, вес не ограничивается
+	MaxWeight *int `json:"maxWeight,omitempty"`
+
+	// Name Название
+	Name string `json:"name"`
+
+	// TotalVolume Объем
+	TotalVolume int `json:"totalVolume"`
 }
 
 // Order defines model for Order.
 type Order struct {
+	// CourierId Идентификатор назначенного курьера
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// Id Идентификатор
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+
+	// Status Статус заказа
+	Status *OrderStatus `json:"status,omitempty"`
+}
+
+// OrderDetails defines model for OrderDetails.
+type OrderDetails struct {
+	// CourierId Идентификатор назначенного курьера
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// History Смены статуса заказа в порядке их возникновения
+	History []OrderStatusChange `json:"history"`
+
 	// Id Идентификатор
 	Id       openapi_types.UUID `json:"id"`
 	Location Location           `json:"location"`
+
+	// Status Статус заказа
+	Status OrderStatus `json:"status"`
+
+	// Volume Объем
+	Volume int `json:"volume"`
+}
+
+// OrderItem defines model for OrderItem.
+type OrderItem struct {
+	// GoodId Идентификатор товара
+	GoodId openapi_types.UUID `json:"goodId"`
+
+	// Quantity Количество
+	Quantity int `json:"quantity"`
+
+	// Title Название
+	Title string `json:"title"`
+}
+
+// OrderPriority Срочность заказа. По умолчанию normal
+type OrderPriority string
+
+// OrderStatus Статус заказа
+type OrderStatus string
+
+// OrderStatusChange defines model for OrderStatusChange.
+type OrderStatusChange struct {
+	// ChangedAt Время смены статуса
+	ChangedAt time.Time `json:"changedAt"`
+
+	// Status Статус заказа
+	Status OrderStatus `json:"status"`
+}
+
+// StoragePlace defines model for StoragePlace.
+type StoragePlace struct {
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// MaxWeight Допустимый вес заказа в граммах, 0 - вес не ограничивается
+	MaxWeight int `json:"maxWeight"`
+
+	// Name Название
+	Name string `json:"name"`
+
+	// OrderId Идентификатор заказа в месте хранения
+	OrderId *openapi_types.UUID `json:"orderId,omitempty"`
+
+	// TotalVolume Объем
+	TotalVolume int `json:"totalVolume"`
+
+	// UsedVolume Занятый объем
+	UsedVolume int `json:"usedVolume"`
+}
+
+// VehicleType Транспорт курьера. По умолчанию foot
+type VehicleType string
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// MaxX defines model for MaxX.
+type MaxX = int
+
+// MaxY defines model for MaxY.
+type MaxY = int
+
+// MinX defines model for MinX.
+type MinX = int
+
+// MinY defines model for MinY.
+type MinY = int
+
+// OrderSort defines model for OrderSort.
+type OrderSort string
+
+// GetCouriersParams defines parameters for GetCouriers.
+type GetCouriersParams struct {
+	// Cursor Курсор из заголовка X-Next-Cursor предыдущей страницы
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Размер страницы. По умолчанию 100
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort Поле сортировки, префикс "-" - по убыванию. По умолчанию id
+	Sort *GetCouriersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Busy true - только курьеры с заказами, false - только свободные
+	Busy *bool `form:"busy,omitempty" json:"busy,omitempty"`
+
+	// Availability Доступность курьеров. Если не задана, возвращаются курьеры с любой доступностью
+	Availability *[]CourierAvailability `form:"availability,omitempty" json:"availability,omitempty"`
+
+	// MinX Левая граница области
+	MinX *MinX `form:"minX,omitempty" json:"minX,omitempty"`
+
+	// MinY Нижняя граница области
+	MinY *MinY `form:"minY,omitempty" json:"minY,omitempty"`
+
+	// MaxX Правая граница области
+	MaxX *MaxX `form:"maxX,omitempty" json:"maxX,omitempty"`
+
+	// MaxY Верхняя граница области
+	MaxY *MaxY `form:"maxY,omitempty" json:"maxY,omitempty"`
+}
+
+// GetCouriersParamsSort defines parameters for GetCouriers.
+type GetCouriersParamsSort string
+
+// StreamCouriersParams defines parameters for StreamCouriers.
+type StreamCouriersParams struct {
+	// CourierId Идентификаторы курьеров. Если не заданы, передаются события всех курьеров
+	CourierId *[]openapi_types.UUID `form:"courierId,omitempty" json:"courierId,omitempty"`
+}
+
+// ListOrdersParams defines parameters for ListOrders.
+type ListOrdersParams struct {
+	// Status Статусы заказов. Если не заданы, возвращаются заказы во всех статусах
+	Status *[]OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Cursor Курсор из заголовка X-Next-Cursor предыдущей страницы
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Размер страницы. По умолчанию 100
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort Поле сортировки, префикс "-" - по убыванию. По умолчанию id
+	Sort *ListOrdersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// CourierId Идентификатор назначенного курьера
+	CourierId *openapi_types.UUID `form:"courierId,omitempty" json:"courierId,omitempty"`
+
+	// MinX Левая граница области
+	MinX *MinX `form:"minX,omitempty" json:"minX,omitempty"`
+
+	// MinY Нижняя граница области
+	MinY *MinY `form:"minY,omitempty" json:"minY,omitempty"`
+
+	// MaxX Правая граница области
+	MaxX *MaxX `form:"maxX,omitempty" json:"maxX,omitempty"`
+
+	// MaxY Верхняя граница области
+	MaxY *MaxY `form:"maxY,omitempty" json:"maxY,omitempty"`
+}
+
+// ListOrdersParamsSort defines parameters for ListOrders.
+type ListOrdersParamsSort string
+
+// GetOrdersParams defines parameters for GetOrders.
+type GetOrdersParams struct {
+	// Cursor Курсор из заголовка X-Next-Cursor предыдущей страницы
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Размер страницы. По умолчанию 100
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort Поле сортировки, префикс "-" - по убыванию. По умолчанию id
+	Sort *GetOrdersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// CourierId Идентификатор назначенного курьера
+	CourierId *openapi_types.UUID `form:"courierId,omitempty" json:"courierId,omitempty"`
+
+	// MinX Левая граница области
+	MinX *MinX `form:"minX,omitempty" json:"minX,omitempty"`
+
+	// MinY Нижняя граница области
+	MinY *MinY `form:"minY,omitempty" json:"minY,omitempty"`
+
+	// MaxX Правая граница области
+	MaxX *MaxX `form:"maxX,omitempty" json:"maxX,omitempty"`
+
+	// MaxY Верхняя граница области
+	MaxY *MaxY `form:"maxY,omitempty" json:"maxY,omitempty"`
 }
 
+// GetOrdersParamsSort defines parameters for GetOrders.
+type GetOrdersParamsSort string
+
 // CreateCourierJSONRequestBody defines body for CreateCourier for application/json ContentType.
 type CreateCourierJSONRequestBody = NewCourier
 
+// AddStoragePlaceJSONRequestBody defines body for AddStoragePlace for application/json ContentType.
+type AddStoragePlaceJSONRequestBody = NewStoragePlace
+
+// CreateOrderJSONRequestBody defines body for CreateOrder for application/json ContentType.
+type CreateOrderJSONRequestBody = NewOrder
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Получить всех курьеров
 	// (GET /api/v1/couriers)
-	GetCouriers(ctx echo.Context) error
+	GetCouriers(ctx echo.Context, params GetCouriersParams) error
 	// Добавить курьера
 	// (POST /api/v1/couriers)
 	CreateCourier(ctx echo.Context) error
+	// Подписаться на перемещения курьеров
+	// (GET /api/v1/couriers/stream)
+	StreamCouriers(ctx echo.Context, params StreamCouriersParams) error
+	// Получить курьера
+	// (GET /api/v1/couriers/{courierId})
+	GetCourier(ctx echo.Context, courierId openapi_types.UUID) error
+	// Завершить перерыв курьера
+	// (POST /api/v1/couriers/{courierId}/break/end)
+	EndCourierBreak(ctx echo.Context, courierId openapi_types.UUID) error
+	// Начать перерыв курьера
+	// (POST /api/v1/couriers/{courierId}/break/start)
+	StartCourierBreak(ctx echo.Context, courierId openapi_types.UUID) error
+	// Завершить смену курьера
+	// (POST /api/v1/couriers/{courierId}/shift/end)
+	EndCourierShift(ctx echo.Context, courierId openapi_types.UUID) error
+	// Начать смену курьера
+	// (POST /api/v1/couriers/{courierId}/shift/start)
+	StartCourierShift(ctx echo.Context, courierId openapi_types.UUID) error
+	// Добавить место хранения курьеру
+	// (POST /api/v1/couriers/{courierId}/storage-places)
+	AddStoragePlace(ctx echo.Context, courierId openapi_types.UUID) error
+	// Получить заказы
+	// (GET /api/v1/orders)
+	ListOrders(ctx echo.Context, params ListOrdersParams) error
 	// Создать заказ
 	// (POST /api/v1/orders)
 	CreateOrder(ctx echo.Context) error
-	// Получить все незавершенные заказы
+	// Получить незавершенные заказы
 	// (GET /api/v1/orders/active)
-	GetOrders(ctx echo.Context) error
+	GetOrders(ctx echo.Context, params GetOrdersParams) error
+	// Получить заказ
+	// (GET /api/v1/orders/{orderId})
+	GetOrder(ctx echo.Context, orderId openapi_types.UUID) error
+	// Отменить заказ
+	// (POST /api/v1/orders/{orderId}/cancel)
+	CancelOrder(ctx echo.Context, orderId openapi_types.UUID) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -93,8 +519,73 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) GetCouriers(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCouriersParams
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "busy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "busy", ctx.QueryParams(), &params.Busy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter busy: %s", err))
+	}
+
+	// ------------- Optional query parameter "availability" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "availability", ctx.QueryParams(), &params.Availability)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter availability: %s", err))
+	}
+
+	// ------------- Optional query parameter "minX" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minX", ctx.QueryParams(), &params.MinX)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minX: %s", err))
+	}
+
+	// ------------- Optional query parameter "minY" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minY", ctx.QueryParams(), &params.MinY)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minY: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxX" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxX", ctx.QueryParams(), &params.MaxX)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxX: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxY" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxY", ctx.QueryParams(), &params.MaxY)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxY: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCouriers(ctx)
+	err = w.Handler.GetCouriers(ctx, params)
 	return err
 }
 
@@ -107,182 +598,1054 @@ func (w *ServerInterfaceWrapper) CreateCourier(ctx echo.Context) error {
 	return err
 }
 
-// CreateOrder converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
+// StreamCouriers converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamCouriers(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamCouriersParams
+	// ------------- Optional query parameter "courierId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "courierId", ctx.QueryParams(), &params.CourierId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateOrder(ctx)
+	err = w.Handler.StreamCouriers(ctx, params)
 	return err
 }
 
-// GetOrders converts echo context to params.
-func (w *ServerInterfaceWrapper) GetOrders(ctx echo.Context) error {
+// GetCourier converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCourier(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetOrders(ctx)
+	err = w.Handler.GetCourier(ctx, courierId)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+// EndCourierBreak converts echo context to params.
+func (w *ServerInterfaceWrapper) EndCourierBreak(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EndCourierBreak(ctx, courierId)
+	return err
 }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+// StartCourierBreak converts echo context to params.
+func (w *ServerInterfaceWrapper) StartCourierBreak(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartCourierBreak(ctx, courierId)
+	return err
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// EndCourierShift converts echo context to params.
+func (w *ServerInterfaceWrapper) EndCourierShift(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EndCourierShift(ctx, courierId)
+	return err
+}
+
+// StartCourierShift converts echo context to params.
+func (w *ServerInterfaceWrapper) StartCourierShift(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartCourierShift(ctx, courierId)
+	return err
+}
+
+// AddStoragePlace converts echo context to params.
+func (w *ServerInterfaceWrapper) AddStoragePlace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddStoragePlace(ctx, courierId)
+	return err
+}
+
+// ListOrders converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOrders(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrdersParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
-	router.GET(baseURL+"/api/v1/couriers", wrapper.GetCouriers)
-	router.POST(baseURL+"/api/v1/couriers", wrapper.CreateCourier)
-	router.POST(baseURL+"/api/v1/orders", wrapper.CreateOrder)
-	router.GET(baseURL+"/api/v1/orders/active", wrapper.GetOrders)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "courierId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "courierId", ctx.QueryParams(), &params.CourierId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Optional query parameter "minX" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minX", ctx.QueryParams(), &params.MinX)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minX: %s", err))
+	}
+
+	// ------------- Optional query parameter "minY" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minY", ctx.QueryParams(), &params.MinY)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minY: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxX" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxX", ctx.QueryParams(), &params.MaxX)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxX: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxY" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxY", ctx.QueryParams(), &params.MaxY)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxY: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOrders(ctx, params)
+	return err
+}
+
+// CreateOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateOrder(ctx)
+	return err
+}
+
+// GetOrders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrders(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrdersParams
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "courierId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "courierId", ctx.QueryParams(), &params.CourierId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Optional query parameter "minX" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minX", ctx.QueryParams(), &params.MinX)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minX: %s", err))
+	}
+
+	// ------------- Optional query parameter "minY" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minY", ctx.QueryParams(), &params.MinY)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minY: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxX" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxX", ctx.QueryParams(), &params.MaxX)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxX: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxY" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxY", ctx.QueryParams(), &params.MaxY)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxY: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrders(ctx, params)
+	return err
+}
+
+// GetOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrder(ctx, orderId)
+	return err
+}
+
+// CancelOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelOrder(ctx, orderId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/api/v1/couriers", wrapper.GetCouriers)
+	router.POST(baseURL+"/api/v1/couriers", wrapper.CreateCourier)
+	router.GET(baseURL+"/api/v1/couriers/stream", wrapper.StreamCouriers)
+	router.GET(baseURL+"/api/v1/couriers/:courierId", wrapper.GetCourier)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/break/end", wrapper.EndCourierBreak)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/break/start", wrapper.StartCourierBreak)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/shift/end", wrapper.EndCourierShift)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/shift/start", wrapper.StartCourierShift)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/storage-places", wrapper.AddStoragePlace)
+	router.GET(baseURL+"/api/v1/orders", wrapper.ListOrders)
+	router.POST(baseURL+"/api/v1/orders", wrapper.CreateOrder)
+	router.GET(baseURL+"/api/v1/orders/active", wrapper.GetOrders)
+	router.GET(baseURL+"/api/v1/orders/:orderId", wrapper.GetOrder)
+	router.POST(baseURL+"/api/v1/orders/:orderId/cancel", wrapper.CancelOrder)
+
+}
+
+type GetCouriersRequestObject struct {
+	Params GetCouriersParams
+}
+
+type GetCouriersResponseObject interface {
+	VisitGetCouriersResponse(w http.ResponseWriter) error
+}
+
+type GetCouriers200ResponseHeaders struct {
+	XNextCursor string
+}
+
+type GetCouriers200JSONResponse struct {
+	Body    []Courier
+	Headers GetCouriers200ResponseHeaders
+}
+
+func (response GetCouriers200JSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCouriers400JSONResponse Error
+
+func (response GetCouriers400JSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCouriersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetCouriersdefaultJSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCourierRequestObject struct {
+	Body *CreateCourierJSONRequestBody
+}
+
+type CreateCourierResponseObject interface {
+	VisitCreateCourierResponse(w http.ResponseWriter) error
+}
+
+type CreateCourier201Response struct {
+}
+
+func (response CreateCourier201Response) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type CreateCourier400JSONResponse Error
+
+func (response CreateCourier400JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCourier409JSONResponse Error
+
+func (response CreateCourier409JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCourierdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateCourierdefaultJSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StreamCouriersRequestObject struct {
+	Params StreamCouriersParams
+}
+
+type StreamCouriersResponseObject interface {
+	VisitStreamCouriersResponse(w http.ResponseWriter) error
+}
+
+type StreamCouriers200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamCouriers200TexteventStreamResponse) VisitStreamCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamCouriers400JSONResponse Error
+
+func (response StreamCouriers400JSONResponse) VisitStreamCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamCouriersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StreamCouriersdefaultJSONResponse) VisitStreamCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCourierRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type GetCourierResponseObject interface {
+	VisitGetCourierResponse(w http.ResponseWriter) error
+}
+
+type GetCourier200JSONResponse CourierDetails
+
+func (response GetCourier200JSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourier400JSONResponse Error
+
+func (response GetCourier400JSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourier404JSONResponse Error
+
+func (response GetCourier404JSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetCourierdefaultJSONResponse) VisitGetCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type EndCourierBreakRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type EndCourierBreakResponseObject interface {
+	VisitEndCourierBreakResponse(w http.ResponseWriter) error
+}
+
+type EndCourierBreak204Response struct {
+}
+
+func (response EndCourierBreak204Response) VisitEndCourierBreakResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type EndCourierBreak400JSONResponse Error
+
+func (response EndCourierBreak400JSONResponse) VisitEndCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndCourierBreak404JSONResponse Error
+
+func (response EndCourierBreak404JSONResponse) VisitEndCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndCourierBreak409JSONResponse Error
+
+func (response EndCourierBreak409JSONResponse) VisitEndCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndCourierBreakdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response EndCourierBreakdefaultJSONResponse) VisitEndCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StartCourierBreakRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type StartCourierBreakResponseObject interface {
+	VisitStartCourierBreakResponse(w http.ResponseWriter) error
+}
+
+type StartCourierBreak204Response struct {
+}
+
+func (response StartCourierBreak204Response) VisitStartCourierBreakResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type StartCourierBreak400JSONResponse Error
+
+func (response StartCourierBreak400JSONResponse) VisitStartCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCourierBreak404JSONResponse Error
+
+func (response StartCourierBreak404JSONResponse) VisitStartCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCourierBreak409JSONResponse Error
+
+func (response StartCourierBreak409JSONResponse) VisitStartCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCourierBreakdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StartCourierBreakdefaultJSONResponse) VisitStartCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type EndCourierShiftRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type EndCourierShiftResponseObject interface {
+	VisitEndCourierShiftResponse(w http.ResponseWriter) error
+}
+
+type EndCourierShift204Response struct {
+}
+
+func (response EndCourierShift204Response) VisitEndCourierShiftResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type EndCourierShift400JSONResponse Error
+
+func (response EndCourierShift400JSONResponse) VisitEndCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndCourierShift404JSONResponse Error
+
+func (response EndCourierShift404JSONResponse) VisitEndCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndCourierShift409JSONResponse Error
+
+func (response EndCourierShift409JSONResponse) VisitEndCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndCourierShiftdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response EndCourierShiftdefaultJSONResponse) VisitEndCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StartCourierShiftRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type StartCourierShiftResponseObject interface {
+	VisitStartCourierShiftResponse(w http.ResponseWriter) error
+}
+
+type StartCourierShift204Response struct {
+}
+
+func (response StartCourierShift204Response) VisitStartCourierShiftResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type StartCourierShift400JSONResponse Error
+
+func (response StartCourierShift400JSONResponse) VisitStartCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCourierShift404JSONResponse Error
+
+func (response StartCourierShift404JSONResponse) VisitStartCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCourierShift409JSONResponse Error
+
+func (response StartCourierShift409JSONResponse) VisitStartCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartCourierShiftdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StartCourierShiftdefaultJSONResponse) VisitStartCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddStoragePlaceRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *AddStoragePlaceJSONRequestBody
+}
+
+type AddStoragePlaceResponseObject interface {
+	VisitAddStoragePlaceResponse(w http.ResponseWriter) error
+}
+
+type AddStoragePlace201Response struct {
+}
+
+func (response AddStoragePlace201Response) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type AddStoragePlace400JSONResponse Error
+
+func (response AddStoragePlace400JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlace404JSONResponse Error
+
+func (response AddStoragePlace404JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlace409JSONResponse Error
+
+func (response AddStoragePlace409JSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddStoragePlacedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response AddStoragePlacedefaultJSONResponse) VisitAddStoragePlaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrdersRequestObject struct {
+	Params ListOrdersParams
+}
+
+type ListOrdersResponseObject interface {
+	VisitListOrdersResponse(w http.ResponseWriter) error
+}
+
+type ListOrders200ResponseHeaders struct {
+	XNextCursor string
+}
+
+type ListOrders200JSONResponse struct {
+	Body    []Order
+	Headers ListOrders200ResponseHeaders
+}
+
+func (response ListOrders200JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrders400JSONResponse Error
+
+func (response ListOrders400JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrdersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ListOrdersdefaultJSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOrderRequestObject struct {
+	Body *CreateOrderJSONRequestBody
+}
+
+type CreateOrderResponseObject interface {
+	VisitCreateOrderResponse(w http.ResponseWriter) error
+}
+
+type CreateOrder200JSONResponse Order
+
+func (response CreateOrder200JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrder201JSONResponse Order
+
+func (response CreateOrder201JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrder400JSONResponse Error
+
+func (response CreateOrder400JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrder409JSONResponse Error
+
+func (response CreateOrder409JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateOrderdefaultJSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
+type GetOrdersRequestObject struct {
+	Params GetOrdersParams
 }
 
-type GetCouriersRequestObject struct {
+type GetOrdersResponseObject interface {
+	VisitGetOrdersResponse(w http.ResponseWriter) error
 }
 
-type GetCouriersResponseObject interface {
-	VisitGetCouriersResponse(w http.ResponseWriter) error
+type GetOrders200ResponseHeaders struct {
+	XNextCursor string
 }
 
-type GetCouriers200JSONResponse []Courier
+type GetOrders200JSONResponse struct {
+	Body    []Order
+	Headers GetOrders200ResponseHeaders
+}
 
-func (response GetCouriers200JSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+func (response GetOrders200JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
 	w.WriteHeader(200)
 
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrders400JSONResponse Error
+
+func (response GetOrders400JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCouriersdefaultJSONResponse struct {
+type GetOrdersdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response GetCouriersdefaultJSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateCourierRequestObject struct {
-	Body *CreateCourierJSONRequestBody
+type GetOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
 }
 
-type CreateCourierResponseObject interface {
-	VisitCreateCourierResponse(w http.ResponseWriter) error
+type GetOrderResponseObject interface {
+	VisitGetOrderResponse(w http.ResponseWriter) error
 }
 
-type CreateCourier201Response struct {
-}
+type GetOrder200JSONResponse OrderDetails
 
-func (response CreateCourier201Response) VisitCreateCourierResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+func (response GetOrder200JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCourier400JSONResponse Error
+type GetOrder400JSONResponse Error
 
-func (response CreateCourier400JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+func (response GetOrder400JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCourier409JSONResponse Error
+type GetOrder404JSONResponse Error
 
-func (response CreateCourier409JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+func (response GetOrder404JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCourierdefaultJSONResponse struct {
+type GetOrderdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response CreateCourierdefaultJSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+func (response GetOrderdefaultJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateOrderRequestObject struct {
+type CancelOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
 }
 
-type CreateOrderResponseObject interface {
-	VisitCreateOrderResponse(w http.ResponseWriter) error
+type CancelOrderResponseObject interface {
+	VisitCancelOrderResponse(w http.ResponseWriter) error
 }
 
-type CreateOrder201Response struct {
+type CancelOrder204Response struct {
 }
 
-func (response CreateOrder201Response) VisitCreateOrderResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
+func (response CancelOrder204Response) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
 	return nil
 }
 
-type CreateOrderdefaultJSONResponse struct {
-	Body       Error
-	StatusCode int
-}
+type CancelOrder400JSONResponse Error
 
-func (response CreateOrderdefaultJSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+func (response CancelOrder400JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+	w.WriteHeader(400)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOrdersRequestObject struct {
-}
+type CancelOrder404JSONResponse Error
 
-type GetOrdersResponseObject interface {
-	VisitGetOrdersResponse(w http.ResponseWriter) error
+func (response CancelOrder404JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOrders200JSONResponse []Order
+type CancelOrder409JSONResponse Error
 
-func (response GetOrders200JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+func (response CancelOrder409JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOrdersdefaultJSONResponse struct {
+type CancelOrderdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+func (response CancelOrderdefaultJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
@@ -297,12 +1660,42 @@ type StrictServerInterface interface {
 	// Добавить курьера
 	// (POST /api/v1/couriers)
 	CreateCourier(ctx context.Context, request CreateCourierRequestObject) (CreateCourierResponseObject, error)
+	// Подписаться на перемещения курьеров
+	// (GET /api/v1/couriers/stream)
+	StreamCouriers(ctx context.Context, request StreamCouriersRequestObject) (StreamCouriersResponseObject, error)
+	// Получить курьера
+	// (GET /api/v1/couriers/{courierId})
+	GetCourier(ctx context.Context, request GetCourierRequestObject) (GetCourierResponseObject, error)
+	// Завершить перерыв курьера
+	// (POST /api/v1/couriers/{courierId}/break/end)
+	EndCourierBreak(ctx context.Context, request EndCourierBreakRequestObject) (EndCourierBreakResponseObject, error)
+	// Начать перерыв курьера
+	// (POST /api/v1/couriers/{courierId}/break/start)
+	StartCourierBreak(ctx context.Context, request StartCourierBreakRequestObject) (StartCourierBreakResponseObject, error)
+	// Завершить смену курьера
+	// (POST /api/v1/couriers/{courierId}/shift/end)
+	EndCourierShift(ctx context.Context, request EndCourierShiftRequestObject) (EndCourierShiftResponseObject, error)
+	// Начать смену курьера
+	// (POST /api/v1/couriers/{courierId}/shift/start)
+	StartCourierShift(ctx context.Context, request StartCourierShiftRequestObject) (StartCourierShiftResponseObject, error)
+	// Добавить место хранения курьеру
+	// (POST /api/v1/couriers/{courierId}/storage-places)
+	AddStoragePlace(ctx context.Context, request AddStoragePlaceRequestObject) (AddStoragePlaceResponseObject, error)
+	// Получить заказы
+	// (GET /api/v1/orders)
+	ListOrders(ctx context.Context, request ListOrdersRequestObject) (ListOrdersResponseObject, error)
 	// Создать заказ
 	// (POST /api/v1/orders)
 	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
-	// Получить все незавершенные заказы
+	// Получить незавершенные заказы
 	// (GET /api/v1/orders/active)
 	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
+	// Получить заказ
+	// (GET /api/v1/orders/{orderId})
+	GetOrder(ctx context.Context, request GetOrderRequestObject) (GetOrderResponseObject, error)
+	// Отменить заказ
+	// (POST /api/v1/orders/{orderId}/cancel)
+	CancelOrder(ctx context.Context, request CancelOrderRequestObject) (CancelOrderResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -318,9 +1711,11 @@ type strictHandler struct {
 }
 
 // GetCouriers operation middleware
-func (sh *strictHandler) GetCouriers(ctx echo.Context) error {
+func (sh *strictHandler) GetCouriers(ctx echo.Context, params GetCouriersParams) error {
 	var request GetCouriersRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetCouriers(ctx.Request().Context(), request.(GetCouriersRequestObject))
 	}
@@ -369,10 +1764,222 @@ func (sh *strictHandler) CreateCourier(ctx echo.Context) error {
 	return nil
 }
 
+// StreamCouriers operation middleware
+func (sh *strictHandler) StreamCouriers(ctx echo.Context, params StreamCouriersParams) error {
+	var request StreamCouriersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamCouriers(ctx.Request().Context(), request.(StreamCouriersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamCouriers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StreamCouriersResponseObject); ok {
+		return validResponse.VisitStreamCouriersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCourier operation middleware
+func (sh *strictHandler) GetCourier(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request GetCourierRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCourier(ctx.Request().Context(), request.(GetCourierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCourier")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCourierResponseObject); ok {
+		return validResponse.VisitGetCourierResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EndCourierBreak operation middleware
+func (sh *strictHandler) EndCourierBreak(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request EndCourierBreakRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EndCourierBreak(ctx.Request().Context(), request.(EndCourierBreakRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EndCourierBreak")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EndCourierBreakResponseObject); ok {
+		return validResponse.VisitEndCourierBreakResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StartCourierBreak operation middleware
+func (sh *strictHandler) StartCourierBreak(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request StartCourierBreakRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StartCourierBreak(ctx.Request().Context(), request.(StartCourierBreakRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartCourierBreak")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StartCourierBreakResponseObject); ok {
+		return validResponse.VisitStartCourierBreakResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EndCourierShift operation middleware
+func (sh *strictHandler) EndCourierShift(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request EndCourierShiftRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EndCourierShift(ctx.Request().Context(), request.(EndCourierShiftRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EndCourierShift")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EndCourierShiftResponseObject); ok {
+		return validResponse.VisitEndCourierShiftResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StartCourierShift operation middleware
+func (sh *strictHandler) StartCourierShift(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request StartCourierShiftRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StartCourierShift(ctx.Request().Context(), request.(StartCourierShiftRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartCourierShift")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StartCourierShiftResponseObject); ok {
+		return validResponse.VisitStartCourierShiftResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddStoragePlace operation middleware
+func (sh *strictHandler) AddStoragePlace(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request AddStoragePlaceRequestObject
+
+	request.CourierId = courierId
+
+	var body AddStoragePlaceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddStoragePlace(ctx.Request().Context(), request.(AddStoragePlaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddStoragePlace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddStoragePlaceResponseObject); ok {
+		return validResponse.VisitAddStoragePlaceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListOrders operation middleware
+func (sh *strictHandler) ListOrders(ctx echo.Context, params ListOrdersParams) error {
+	var request ListOrdersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrders(ctx.Request().Context(), request.(ListOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListOrdersResponseObject); ok {
+		return validResponse.VisitListOrdersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // CreateOrder operation middleware
 func (sh *strictHandler) CreateOrder(ctx echo.Context) error {
 	var request CreateOrderRequestObject
 
+	var body CreateOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.CreateOrder(ctx.Request().Context(), request.(CreateOrderRequestObject))
 	}
@@ -393,9 +2000,11 @@ func (sh *strictHandler) CreateOrder(ctx echo.Context) error {
 }
 
 // GetOrders operation middleware
-func (sh *strictHandler) GetOrders(ctx echo.Context) error {
+func (sh *strictHandler) GetOrders(ctx echo.Context, params GetOrdersParams) error {
 	var request GetOrdersRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetOrders(ctx.Request().Context(), request.(GetOrdersRequestObject))
 	}
@@ -415,24 +2024,121 @@ func (sh *strictHandler) GetOrders(ctx echo.Context) error {
 	return nil
 }
 
+// GetOrder operation middleware
+func (sh *strictHandler) GetOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request GetOrderRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrder(ctx.Request().Context(), request.(GetOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderResponseObject); ok {
+		return validResponse.VisitGetOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CancelOrder operation middleware
+func (sh *strictHandler) CancelOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request CancelOrderRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelOrder(ctx.Request().Context(), request.(CancelOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelOrderResponseObject); ok {
+		return validResponse.VisitCancelOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xVT28bRRT/KqsHx1Vtt1zYIwEhpIgeuIAQh8V+caby7iyz47RWtFLsQBspUXPhgHoo",
-	"KnyBjcnKi0Pcr/DeN0IzEyduPHFcEVURF8u7O/Pm9++92YW2TDKZYqpziHYhb29jEtu/G7KvBCrzN1My",
-	"Q6UF2g+iY347mLeVyLSQKURAv9EpVXTOI6r5Z6ppSiWPaMZ7EMKWVEmsIYJ+X3QgBD3IECLItRJpF4oQ",
-	"erIdu0K78LHCLYjgo8YVsMYFqsbmfF0RQhon6MXxDx8vn1GEoPCnvlDYgeh7sDBshYXDf7jcJX98gm1t",
-	"TvlCKemRoC07vsNf0YxOA5rxAdV0QlOqF9mLVD96eAVNpBq7qMwpCeZ53PVV/IMqmvKQR9erruZn8V3V",
-	"9THbXND8XXLPlnF8a4qJVCT9BKKmj8JgedN3t2y6hvkZmCo+qF/j0xvDeEsMEpFuYtrV2xC1PMHLM0Rf",
-	"mt/Q1GSXZkZ6Plok0rqVyEWuXG0fn8eqc1/7ytcnKxrErBfplvQAf80jGlPFL6ikyuR3QmXA+/zCPU15",
-	"n/f4iCqjMo3DwPDkIb01n+2iParNHn5ONb80n60ZVNKYZjQN330z5X0jgNA9A++bp3G3iyr4HHtiB9UA",
-	"QthBlTtkrQfNB02jjswwjTMBETyyr0LIYr1trWjEmWjstBptFzv7rovaQ/N3mtHEQjrjY0ftrX0wTGsT",
-	"noDGPKSKf1kiDRaDsuJ+1YEIvkS9MT/RGJFnMs1dOB42m27wpBpTCyTOsp5wzjSe5M5kZ6gNk8Ykv833",
-	"eVcVl8bGSsUD5+s1on9emHNA53xIf5t55AwegV28Ffd7+r0grkLm5q4Px+vLMVjauOb9JInVYO7FesIX",
-	"IWQyX9PPU5rRiU3ZRdnFauWSiRsKY41zaV0/Ya4/k53BncmzMBF9Gr26AgjFUpBaHtqr3f3kPcP3n50N",
-	"aEwlnVFNp24CUO1wfPrBcfCha2g6N3OYaj4O6MSOpnMzsAI6oxn9ZSdzfW864dfVkTWr5yNOmsvIJmPt",
-	"juChfWWsscUnVBoQNAl4GPBzquiMj/hlwCOjkL26bNtR6QS8oWXctXgHcb0XFry5QSOP+I24rcUO3sEl",
-	"E9iUTqzzFe/xgc2s0ahagMCHvpvnsQvCh7h3nNP/61tnbSeKoij+DQAA//8JDQamhg0AAA==",
+	"H4sIAAAAAAAC/+xcX2/bRhL/KgTvHu4A2nLavJzf0rQ4FEj/ACnaBk0RMNLaZiuRKkk5CQIBlnVpklMQ",
+	"43o99JBr0+v14e6RVq1YsST6K8x+o8PMLin+WUqU7aROqpdElsjd2dmZ3/xmdsi7etVpNB2b2b6nr9/V",
+	"m6ZrNpjPXPrrcsv1HBc/1ZhXda2mbzm2vq7DE97lO7wDId/RYAiHGhxCAL9ACCMIoQ9HEGifrrzPbvsr",
+	"YgwNjvkODOCA9+CAd/lDGMBzjXf4Lt+BACYw5F/znm7oFo7/VYu5d3RDt80G09f1qhDD0L3qFmuYKI9/",
+	"p4m/eL5r2Zt6u23oV6yG5StE/TcEcAhjGPCd3HyrGvwIoca7MEbR+X3502PtwtpagTB1micpS8O8bTVa",
+	"DX39wtramqE3LFv+aURiWrbPNplLcr5n3v5UIeaPJFcfAr6nwS9TISHQIIR9GEGA0sOwQKwGDpuSKhJj",
+	"rUiMawoxvkE98Xsw4XsnF+TaQoJYtkof/4LBabSBgy4mhEobP8AQnp1GFzjsAmJ84NaYe9VxVXb8IznX",
+	"QBNeh5PyHelrQ0O6F/8LDOGId7Tr+sp1XVvR4FiY9z7vQV9K/7jY6q1awUI8lCm5EGbjKj7T6Y4V+nfb",
+	"qbcaDP+MPzVdy3EtHwdaiT9/buS8tx0NLVDHabkWI9hpuk6Tub7F6Adz27Tq5k2rjsOs39V/77INfV3/",
+	"XWWKYRU5TkUOcil5S9tAefOq/SccwAAmpFPSIAR8F7WsG/qG4zZMX1/XWy1aZUZ0Q687VVMMNFugK9F1",
+	"7UirCjnGfE81xzbbsqp19hF9P3uajxOXol5d9lXLclkt3iyaOyF2enQjreTpXjk3v2BVH4VRaTa/lm8h",
+	"JLTtwjFM5OdHGhxR4HiEKAOBbsR25Gxs3PC2rA20MsdOfrzpMvNLhdHEgrzNfNOqe0tziefwmoypBP8J",
+	"jlDMaDf0PAYZuuc7rrnJPqybVeYpxvgeBng3BBq/JwFxQOiBklg+a3jzVnY1MYPejmUwXdcknfumu8n8",
+	"khv2kbj41/SRSN9Z3c3wnY/iJWa0+zcYUcgJ4Dl/QNGPLOs+ESoYQ8B3+AO+w7u0A2lvWtXgKd/lHfyR",
+	"tqjPuzDgu4aGW4YDa7ybuUfD7eO7gr4dEVMKoa8bWU+qRiabkfe/KF5qTIxP6B4jCMTI/ekSBgmHb1rV",
+	"L1tN3dBrrG5tY7BRuTjzfKth+qx2yXWtbbN+SaW0p/AMhnCAE1JEG2jQJ7I55nsiMA4xAJK/7pXQEhxA",
+	"OBV6SDqCEX8EhzSedB8MvkMBaql9SYJAzfTZim+RMZ0JEjhIEN5dCJSSexvMR6iMO0QTppxBmoPKwN+W",
+	"u/mJZdecW8rN2ocBf0jAMeE9eI75A4qN9ogUZUT6Fxgj2E3OHDdcp6FkawFxmRGEuUEhKL0vvqNKeCAk",
+	"V/n65CNnNEuLoNmUerQazPYsx1Zh8N8hgH20OTLAHvlYBwKx+5TqUJbD7+U0t8WszS2VD33De0Qsd2k5",
+	"s1IYQ68ze9PfUsZ8RK9JiSFuWTXlCP8jVltmjIw2pUzRyEa0UpVu33FdR8Euq06NFez8gQYhf4AwIu0x",
+	"3m/L9t98QxlIG8zzzE3ViP+BAZJ0vpsddbbBkHzTcVUru5KAlPTibufl+FQ3ZuYjhq7gddfm3JSR+baO",
+	"o6hEfZ/dKuT5c/hOw7KvSBO8cHL2U5wFQRgVKwj398ifOhSLBePhHTiWSVgI47nGfkbMhLRSoEpKHPOK",
+	"rOXQeNbkGexuG3othUIz751e+UJoc8wsc5sah4psoCtFRklx7/qsoWKiccpaZpAPo4uJRLtMye9+hpGo",
+	"H8w3Y5lEKyMo/yuym/koW4j2yHUwbMiixhjGMlws4NtWTY9XGotbYJ8pzp8z04Z5+5MiUb8lb+yKMguM",
+	"BWPoC/kT+51fzKoG/4gI3QQG4mokiRMjHoC+DxOVnfswpKguPX4uQhYg1Q8klCy4wGD+ZvuOb9Y/Pu2O",
+	"qwAjPbZqewqwoyrQeVGyOaGlT4iKYWI4Qf1mcoQyPPS85N6eb/qtcjByVVyq9JN46sIdKCxj/OobsWVh",
+	"YntHib5jmr6nCRDGeg8y0bxrini5BweYBmow5PfQCUOSEMWeUClzwUpCQuuXt0x7U1lOeGXNqGQIKIfS",
+	"NGSiRpvI5qLtLbRMCo85s9x0nNqCNon/UVG/pOF91TJtX11efELVcARsUY7qQzg3HPqWXz8DtM4oWOoh",
+	"Gj4hdaFCP0zwiqxHEUG9nyyZJlypmLPaqMt6or5Sd27phh5/vWVtbikrLEmTU4kTe3WWXkUTVV2GBRrd",
+	"0E3PszZt+ojVHVa7QQUetPM6E5dUTbvK6qw2TxTpznkspO9rl9QpbFTz4Z0CXCpdAzg96scuNxVZZQ+z",
+	"idHZY9cLoVqGtqatxJeWIFWnIFK5FZ22JEbLGUsYGaiq2nOVWp695Rfe8lit8NbvUBK+x3fFXoQLwr6C",
+	"AKYmTJqDyjg/Tieu2TJGNhnO16MLwGrDcfwEgsg/b1rVO1VCUK/qOD5zCS/cgvNCy95Q1emeUiwY8PtR",
+	"+Rl3mHcpTmQEDKFvYK1xQCtA47xP3w/xHv41SZoqRYZwZKS/OeLdGPjX9au3zM1N5mpRHk3HBq4nJLuw",
+	"ura6RvbaZLbZtPR1/U36ytCbpr9F7l4xm1Zl+0JFMj4RZlnRYfAhiSTrE8SwYEQrHeaP2sKIgyUbICjK",
+	"6CSRS2QAvUj/M/MvR/MbqX6Qz9RoOL2kIvtF2sbcK0W3RttQruxcH3NLp1rJlGOm7CC7JN9tMW1FkB88",
+	"RTjKkHCKUilIgjGudcOse7kbeYd2fR+LklQ/HxQs5WbLu6PqmLnpOHVm2ipBy5zZhtAvTqwhMCJW36cC",
+	"9EMI+GNZPFMsecQf00qex16Vnpo/Llhc9vwtXmSptKHgrDedOJSwYepcKXfdtVLXYQtPueuu6e3PEfC9",
+	"pmN7giu8sbYmskXbZzZhhtls1i1B8ytfeCJfOZmqFOppG1nr+Vni6IPoXCeUWLxL5XizJhEt1Rc2p7eM",
+	"7GxAHWOPC3rGig70KAOWoCcGgYliAHKg4r4yXOfFBTU7S6Hi7EGlvqfxUUCgyZMlOtTESARDqsayDbNV",
+	"91+uLMQsvFajYbp3YnxOhpk+78CA30v5Nx0iYwHV8UpGrwMCNYypQ3WjSDpIXaakI7JOQX2Y57/l1O6c",
+	"mXoSJxQqHT2ZCqi3c754QVX8LXaQc2RlF9f+9NLl4D1BX6a0W4N9ImITkVOMqHg1pHOy8+IJ3842Wbw6",
+	"S+gqWC43GzN5HYX7I81j7jZzVzxm+xrbRmkpZB7T6ANKWB5GykK+kOd7f0AMjRofYKBJIW40nG1WIyIb",
+	"ncEPNGno7+FP7+Bsf7xuwzBOpCGUuBmn0mH6vEU1HyVmN0QefENmwZlpcxm/nHz1ug1PyDQpfzM0Orqj",
+	"3E14DvIOkuNYtIkSiAhMIhEfCeDflZwdPx0h3YjyAsFIRKsHjOAZzjNV7jGxq+gG2l284bqdw6GrtJ/F",
+	"fLlsRsp7uf0rplm8Z0xlPUhQrOQG8L0Z0FzQZh0XmpWUan4anGYI8wmKz277FTLulaljzIjFRrG7pGzv",
+	"ub6M2vmofQDHMMQqWGTPMUNSQIoinKvw7G5sM+2zS1YDQrqoJEPZUK4sY8gzBHrsABuv4BAxgtqJNNhP",
+	"tdER6YvaueiSTCPdjBz45C6dpzDkc5jsq11uWr7BjDHpgvNatk6bCpTIAKLTqQWJ/7niNRdfghwJVihh",
+	"G8/hngsjOb8kvgR1Sbh6hXqyK8ymqmsByU9pIlMUmIbgCXm7LNd3NcE5xGFgkISJINOmyns5p33Hrklj",
+	"fQvFe0U996ISQAmhifxI2tWnPx9EVrV0spSTvZwcRilGHE/FbsHg3Dj9dwmzGUYcOWVYJ0IBzzddvywO",
+	"qBQkdTfL11e15DD5dAA7Bw5zdxlY+32O/q2aWE6LteFnskiV5fam6/82EEV0iQTLgH3OsCTOv7FlRqSC",
+	"alM/NyDzQ2RJp4cXegZsAZLBu/wepTlDrAV0IIzVh9n1cCqOyCoyeUiQabY4OejAYQ5okwxnDupMWcxV",
+	"+Qzc64I5slkMgrSG6Ksl7ixx54zJTdLnToQ8CxGbPu8l0EeR1sgYS4+2lMKe5NNMqYYmCKNiSOLRBDjA",
+	"4oqG3/OO6HYQD6kf00Pqu7w3k9+8xlgTc5slypwLlOFdeCYFSeDMuSQwp4EQ0de30oyfoz6bs1DenVZG",
+	"Q1VVFPdXPPIav/IEG2COCIUElO/Tq1oCfLMFOnEOGC7Vaqm2xFcGFl7IGXD6cXWFMX1fuB25FZU7Jy4e",
+	"MGkbI/oyXGLar4Rpr+MJ9niG5aVAKAWAdNB7ymbFBPehhtyoNWycPnYelO1ivGJ5/gdCrnnwlehx572E",
+	"JCUOYQt73dLLgXB6FptajnjmTtmJGLWPL9iwlXmCZOGetpN0cM65cPpSo7axSOwo/QBT+bPsue8+WPb8",
+	"yf1advwteweKDgsz+X9pYos7DIe0wvQ4olMb+sLzI2M6lEw25B1sCMCnM2CsieRhWAQbFDJUx41R3jEV",
+	"AibJmUiQgj5D4REvjGFKh1PsyncJweYxybOz2BLyRNqkjvtRSqlaTBwoqZMU9wULtmytfK2I6U8FQKGg",
+	"nRWz6lvb7HTsc0LFzXRNWnQopplc6Sdoiqjnkn0t2deSfS3Z1ynYVzmsUiHlXfmobPuMUnWNdxQ4wXsw",
+	"TqMEUbIhNmx2JEkbSsNQP6YtOpTVmHqaYmTm9UD5UuT0ZXfnsxcz9Z6QZSfmbDmmXPUV6sOcyXRi/62I",
+	"1ygsdqqApjCWHDA9V761gRrBpd6SzztTzXlVm1mhzrxfNEw8sfpMvKQzfg1AJteiRb2qXn6x4PF9aYJT",
+	"7f8mOzbnOOPLSYzSQkzf6JrxjHODDk8LPZaI0v8HANWJCtu7YQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -4,6 +4,8 @@ import (
 	"context"
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/pkg/errs"
+	"sync"
+	"time"
 
 	"github.com/labstack/gommon/log"
 	"github.com/robfig/cron/v3"
@@ -13,6 +15,9 @@ var _ cron.Job = &MoveCouriersJob{}
 
 type MoveCouriersJob struct {
 	moveCouriersCommandHandler commands.MoveCouriersCommandHandler
+	// mu защищает lastRunAt: cron запускает следующий шаг, не дожидаясь окончания предыдущего
+	mu        sync.Mutex
+	lastRunAt time.Time
 }
 
 func NewMoveCouriersJob(
@@ -22,14 +27,27 @@ func NewMoveCouriersJob(
 	}
 
 	return &MoveCouriersJob{
-		moveCouriersCommandHandler: moveCouriersCommandHandler}, nil
+		moveCouriersCommandHandler: moveCouriersCommandHandler,
+		lastRunAt:                  time.Now()}, nil
 }
 
+// Run пропускает шаг, если предыдущий еще выполняется. Пропущенное время
+// учитывается в следующем шаге, поэтому курьеры не теряют пройденный путь
 func (j *MoveCouriersJob) Run() {
+	if !j.mu.TryLock() {
+		return
+	}
+	defer j.mu.Unlock()
+
 	ctx := context.Background()
-	command, err := commands.NewMoveCouriersCommand()
+	now := time.Now()
+	elapsed := now.Sub(j.lastRunAt)
+	j.lastRunAt = now
+
+	command, err := commands.NewMoveCouriersCommand(elapsed)
 	if err != nil {
 		log.Error(err)
+		return
 	}
 	err = j.moveCouriersCommandHandler.Handle(ctx, command)
 	if err != nil {
@@ -0,0 +1,58 @@
+package jobs
+
+import (
+	"context"
+	"delivery/internal/core/application/usecases/commands"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveCouriersJob_SkipsOverlappingRuns(t *testing.T) {
+	handler := &blockingMoveCouriersHandler{started: make(chan struct{}), release: make(chan struct{})}
+	job, err := NewMoveCouriersJob(handler)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		job.Run()
+	}()
+	<-handler.started
+
+	// шаг, запущенный во время предыдущего, пропускается
+	job.Run()
+	close(handler.release)
+	wg.Wait()
+
+	time.Sleep(10 * time.Millisecond)
+	job.Run()
+
+	require.Len(t, handler.elapsed, 2)
+	assert.GreaterOrEqual(t, handler.elapsed[1], 10*time.Millisecond)
+}
+
+type blockingMoveCouriersHandler struct {
+	mu      sync.Mutex
+	elapsed []time.Duration
+	started chan struct{}
+	release chan struct{}
+}
+
+func (h *blockingMoveCouriersHandler) Handle(_ context.Context, command commands.MoveCouriersCommand) error {
+	h.mu.Lock()
+	h.elapsed = append(h.elapsed, command.Elapsed())
+	first := len(h.elapsed) == 1
+	h.mu.Unlock()
+
+	if first {
+		close(h.started)
+		<-h.release
+	}
+
+	return nil
+}
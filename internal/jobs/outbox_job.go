@@ -0,0 +1,107 @@
+package jobs
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/outbox"
+	"time"
+
+	"github.com/labstack/gommon/log"
+	"github.com/robfig/cron/v3"
+)
+
+const outboxBatchSize = 20
+
+var _ cron.Job = &OutboxJob{}
+
+type OutboxJob struct {
+	uowFactory    ports.UnitOfWorkFactory
+	eventRegistry outbox.EventRegistry
+	orderProducer ports.OrderProducer
+}
+
+func NewOutboxJob(
+	uowFactory ports.UnitOfWorkFactory,
+	eventRegistry outbox.EventRegistry,
+	orderProducer ports.OrderProducer) (cron.Job, error) {
+	if uowFactory == nil {
+		return nil, errs.NewValueIsRequiredError("uowFactory")
+	}
+
+	if eventRegistry == nil {
+		return nil, errs.NewValueIsRequiredError("eventRegistry")
+	}
+
+	if orderProducer == nil {
+		return nil, errs.NewValueIsRequiredError("orderProducer")
+	}
+
+	return &OutboxJob{
+		uowFactory:    uowFactory,
+		eventRegistry: eventRegistry,
+		orderProducer: orderProducer,
+	}, nil
+}
+
+func (j *OutboxJob) Run() {
+	ctx := context.Background()
+
+	err := j.publishMessages(ctx)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// publishMessages публикует пачку сообщений и отмечает результат в одной транзакции.
+// Строки заблокированы до конца транзакции, поэтому параллельный запуск не опубликует их повторно.
+func (j *OutboxJob) publishMessages(ctx context.Context) error {
+	uow, err := j.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.RollbackUnlessCommitted(ctx)
+
+	uow.Begin(ctx)
+
+	messages, err := uow.OutboxRepository().GetNotPublishedMessages(ctx, outboxBatchSize)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, message := range messages {
+		// сообщения публикуются строго по порядку: пока предыдущее не опубликовано, следующие ждут
+		if !message.IsDue(now) {
+			break
+		}
+
+		publishErr := j.publish(ctx, message)
+		if publishErr != nil {
+			message.MarkFailed(publishErr, now)
+			log.Warnf("outbox message %s is not published, attempt %d: %v", message.ID, message.Attempts, publishErr)
+		} else {
+			message.MarkProcessed(now)
+		}
+
+		err = uow.OutboxRepository().Update(ctx, message)
+		if err != nil {
+			return err
+		}
+
+		if publishErr != nil {
+			break
+		}
+	}
+
+	return uow.Commit(ctx)
+}
+
+func (j *OutboxJob) publish(ctx context.Context, message *outbox.Message) error {
+	domainEvent, err := j.eventRegistry.DecodeDomainEvent(message)
+	if err != nil {
+		return err
+	}
+
+	return j.orderProducer.Publish(ctx, domainEvent)
+}
@@ -0,0 +1,130 @@
+package jobs
+
+import (
+	"context"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/ddd"
+	"delivery/internal/pkg/outbox"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxJob_StopsAtFirstFailedMessage(t *testing.T) {
+	assigned := &outbox.Message{ID: uuid.New(), Name: "assigned"}
+	pickedUp := &outbox.Message{ID: uuid.New(), Name: "picked-up"}
+	completed := &outbox.Message{ID: uuid.New(), Name: "completed"}
+	repository := &fakeOutboxRepository{messages: []*outbox.Message{assigned, pickedUp, completed}}
+	producer := &fakeOrderProducer{failing: "picked-up"}
+
+	job, err := NewOutboxJob(&fakeUnitOfWorkFactory{repository: repository}, fakeEventRegistry{}, producer)
+	require.NoError(t, err)
+
+	job.Run()
+
+	// completed не публикуется раньше picked-up, который ждет повторной попытки
+	assert.Equal(t, []string{"assigned", "picked-up"}, producer.attempted)
+	assert.NotNil(t, assigned.ProcessedAtUtc)
+	assert.Nil(t, pickedUp.ProcessedAtUtc)
+	assert.Equal(t, 1, pickedUp.Attempts)
+	assert.Nil(t, completed.ProcessedAtUtc)
+	assert.Zero(t, completed.Attempts)
+
+	// пока отложенное сообщение не пора повторять, следующие тоже ждут
+	job.Run()
+	assert.Len(t, producer.attempted, 2)
+
+	past := time.Now().UTC().Add(-time.Second)
+	pickedUp.NextAttemptAtUtc = &past
+	producer.failing = ""
+	job.Run()
+
+	assert.Equal(t, []string{"assigned", "picked-up", "picked-up", "completed"}, producer.attempted)
+	assert.NotNil(t, completed.ProcessedAtUtc)
+}
+
+type fakeUnitOfWorkFactory struct {
+	repository *fakeOutboxRepository
+}
+
+func (f *fakeUnitOfWorkFactory) New(_ context.Context) (ports.UnitOfWork, error) {
+	return &fakeUnitOfWork{repository: f.repository}, nil
+}
+
+// fakeUnitOfWork реализует только то, что нужно OutboxJob
+type fakeUnitOfWork struct {
+	ports.UnitOfWork
+	repository *fakeOutboxRepository
+}
+
+func (u *fakeUnitOfWork) Begin(_ context.Context) {}
+
+func (u *fakeUnitOfWork) Commit(_ context.Context) error {
+	return nil
+}
+
+func (u *fakeUnitOfWork) RollbackUnlessCommitted(_ context.Context) {}
+
+func (u *fakeUnitOfWork) OutboxRepository() ports.OutboxRepository {
+	return u.repository
+}
+
+type fakeOutboxRepository struct {
+	messages []*outbox.Message
+}
+
+func (r *fakeOutboxRepository) Add(_ context.Context, message *outbox.Message) error {
+	r.messages = append(r.messages, message)
+	return nil
+}
+
+func (r *fakeOutboxRepository) Update(_ context.Context, _ *outbox.Message) error {
+	return nil
+}
+
+func (r *fakeOutboxRepository) GetNotPublishedMessages(_ context.Context, limit int) ([]*outbox.Message, error) {
+	var notPublished []*outbox.Message
+	for _, message := range r.messages {
+		if message.ProcessedAtUtc == nil && len(notPublished) < limit {
+			notPublished = append(notPublished, message)
+		}
+	}
+
+	return notPublished, nil
+}
+
+type fakeEventRegistry struct {
+	outbox.EventRegistry
+}
+
+func (fakeEventRegistry) DecodeDomainEvent(message *outbox.Message) (ddd.DomainEvent, error) {
+	return fakeDomainEvent{name: message.Name}, nil
+}
+
+type fakeDomainEvent struct {
+	ddd.DomainEvent
+	name string
+}
+
+type fakeOrderProducer struct {
+	failing   string
+	attempted []string
+}
+
+func (p *fakeOrderProducer) Publish(_ context.Context, domainEvent ddd.DomainEvent) error {
+	name := domainEvent.(fakeDomainEvent).name
+	p.attempted = append(p.attempted, name)
+	if name == p.failing {
+		return errors.New("broker is down")
+	}
+
+	return nil
+}
+
+func (p *fakeOrderProducer) Close() error {
+	return nil
+}
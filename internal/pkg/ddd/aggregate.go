@@ -3,6 +3,7 @@ package ddd
 type BaseAggregate[ID comparable] struct {
 	baseEntity   *BaseEntity[ID]
 	domainEvents []DomainEvent
+	version      int64
 }
 
 func NewBaseAggregate[ID comparable](id ID) *BaseAggregate[ID] {
@@ -12,10 +13,30 @@ func NewBaseAggregate[ID comparable](id ID) *BaseAggregate[ID] {
 	}
 }
 
+// RestoreBaseAggregate восстанавливает агрегат из хранилища вместе с его версией
+func RestoreBaseAggregate[ID comparable](id ID, version int64) *BaseAggregate[ID] {
+	return &BaseAggregate[ID]{
+		baseEntity:   NewBaseEntity[ID](id),
+		domainEvents: make([]DomainEvent, 0),
+		version:      version,
+	}
+}
+
 func (ba *BaseAggregate[ID]) ID() ID {
 	return ba.baseEntity.ID()
 }
 
+// Version возвращает версию агрегата, с которой он был прочитан из хранилища.
+// Используется для оптимистичной блокировки при сохранении
+func (ba *BaseAggregate[ID]) Version() int64 {
+	return ba.version
+}
+
+// IncrementVersion вызывается после фиксации транзакции, в которой агрегат был сохранен
+func (ba *BaseAggregate[ID]) IncrementVersion() {
+	ba.version++
+}
+
 func (ba *BaseAggregate[ID]) Equal(other *BaseAggregate[ID]) bool {
 	if other == nil {
 		return false
@@ -5,3 +5,10 @@ type AggregateRoot interface {
 	ClearDomainEvents()
 	RaiseDomainEvent(DomainEvent)
 }
+
+// VersionedAggregate - агрегат с версией для оптимистичной блокировки
+type VersionedAggregate interface {
+	AggregateRoot
+	Version() int64
+	IncrementVersion()
+}
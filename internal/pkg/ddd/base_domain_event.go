@@ -0,0 +1,36 @@
+package ddd
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BaseDomainEvent встраивается в конкретные доменные события.
+// Name должен совпадать с именем типа события, иначе outbox.EventRegistry не сможет его восстановить.
+// В JSON он называется EventName, чтобы не конфликтовать с полем Name самого события
+type BaseDomainEvent struct {
+	ID         uuid.UUID
+	Name       string `json:"EventName"`
+	OccurredAt time.Time
+}
+
+func NewBaseDomainEvent(name string) BaseDomainEvent {
+	return BaseDomainEvent{
+		ID:         uuid.New(),
+		Name:       name,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func (e BaseDomainEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e BaseDomainEvent) GetName() string {
+	return e.Name
+}
+
+func (e BaseDomainEvent) GetOccurredAt() time.Time {
+	return e.OccurredAt
+}
@@ -1,34 +1,75 @@
 package ddd
 
-import "context"
+import (
+	"context"
+	"reflect"
+	"sync"
+)
 
 type EventHandler interface {
 	Handle(ctx context.Context, event DomainEvent) error
 }
 
+// Mediatr доставляет доменные события обработчикам.
+// Обработчики, подписанные через SubscribePreCommit, вызываются внутри транзакции
+// и могут её отменить, вернув ошибку. Обработчики, подписанные через Subscribe,
+// вызываются после фиксации транзакции.
+// Подписка выполняется по типу события, поэтому для подписки достаточно пустого значения типа.
 type Mediatr interface {
 	Subscribe(handler EventHandler, events ...DomainEvent)
+	SubscribePreCommit(handler EventHandler, events ...DomainEvent)
 	Publish(ctx context.Context, event DomainEvent) error
+	PublishPreCommit(ctx context.Context, event DomainEvent) error
 }
 
 type mediatr struct {
-	handlers map[string][]EventHandler
+	mu                sync.RWMutex
+	handlers          map[reflect.Type][]EventHandler
+	preCommitHandlers map[reflect.Type][]EventHandler
 }
 
 func NewMediatr() Mediatr {
-	return &mediatr{handlers: make(map[string][]EventHandler)}
+	return &mediatr{
+		handlers:          make(map[reflect.Type][]EventHandler),
+		preCommitHandlers: make(map[reflect.Type][]EventHandler),
+	}
 }
 
 func (e *mediatr) Subscribe(handler EventHandler, events ...DomainEvent) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	subscribe(e.handlers, handler, events...)
+}
+
+func (e *mediatr) SubscribePreCommit(handler EventHandler, events ...DomainEvent) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	subscribe(e.preCommitHandlers, handler, events...)
+}
+
+func (e *mediatr) Publish(ctx context.Context, event DomainEvent) error {
+	e.mu.RLock()
+	handlers := e.handlers[eventType(event)]
+	e.mu.RUnlock()
+	return publish(ctx, handlers, event)
+}
+
+func (e *mediatr) PublishPreCommit(ctx context.Context, event DomainEvent) error {
+	e.mu.RLock()
+	handlers := e.preCommitHandlers[eventType(event)]
+	e.mu.RUnlock()
+	return publish(ctx, handlers, event)
+}
+
+func subscribe(subscriptions map[reflect.Type][]EventHandler, handler EventHandler, events ...DomainEvent) {
 	for _, event := range events {
-		handlers := e.handlers[event.GetName()]
-		handlers = append(handlers, handler)
-		e.handlers[event.GetName()] = handlers
+		key := eventType(event)
+		subscriptions[key] = append(subscriptions[key], handler)
 	}
 }
 
-func (e *mediatr) Publish(ctx context.Context, event DomainEvent) error {
-	for _, handler := range e.handlers[event.GetName()] {
+func publish(ctx context.Context, handlers []EventHandler, event DomainEvent) error {
+	for _, handler := range handlers {
 		err := handler.Handle(ctx, event)
 		if err != nil {
 			return err
@@ -36,3 +77,12 @@ func (e *mediatr) Publish(ctx context.Context, event DomainEvent) error {
 	}
 	return nil
 }
+
+// eventType возвращает тип события, указатель на событие подписан так же, как само событие
+func eventType(event DomainEvent) reflect.Type {
+	t := reflect.TypeOf(event)
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,108 @@
+package ddd_test
+
+import (
+	"context"
+	"delivery/internal/pkg/ddd"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMediatr_Publish(t *testing.T) {
+	mediatr := ddd.NewMediatr()
+	handler := &testEventHandler{}
+	preCommitHandler := &testEventHandler{}
+	mediatr.Subscribe(handler, firstEvent{})
+	mediatr.SubscribePreCommit(preCommitHandler, firstEvent{}, secondEvent{})
+
+	event := firstEvent{ID: uuid.New()}
+
+	err := mediatr.PublishPreCommit(context.Background(), event)
+	require.NoError(t, err)
+	assert.Equal(t, []ddd.DomainEvent{event}, preCommitHandler.events)
+	assert.Empty(t, handler.events)
+
+	err = mediatr.Publish(context.Background(), event)
+	require.NoError(t, err)
+	assert.Equal(t, []ddd.DomainEvent{event}, handler.events)
+	assert.Len(t, preCommitHandler.events, 1)
+}
+
+func TestMediatr_PublishWithoutSubscribers(t *testing.T) {
+	mediatr := ddd.NewMediatr()
+	handler := &testEventHandler{}
+	mediatr.Subscribe(handler, firstEvent{})
+
+	err := mediatr.Publish(context.Background(), secondEvent{ID: uuid.New()})
+	require.NoError(t, err)
+	assert.Empty(t, handler.events)
+}
+
+func TestMediatr_PublishStopsOnHandlerError(t *testing.T) {
+	mediatr := ddd.NewMediatr()
+	failing := &testEventHandler{err: errors.New("handler failed")}
+	next := &testEventHandler{}
+	mediatr.SubscribePreCommit(failing, firstEvent{})
+	mediatr.SubscribePreCommit(next, firstEvent{})
+
+	err := mediatr.PublishPreCommit(context.Background(), firstEvent{ID: uuid.New()})
+	require.Error(t, err)
+	assert.Empty(t, next.events)
+}
+
+func TestMediatr_SubscribesByEventType(t *testing.T) {
+	mediatr := ddd.NewMediatr()
+	handler := &testEventHandler{}
+	mediatr.Subscribe(handler, namedEvent{})
+
+	event := namedEvent{BaseDomainEvent: ddd.NewBaseDomainEvent("namedEvent")}
+	require.NoError(t, mediatr.Publish(context.Background(), event))
+	require.NoError(t, mediatr.Publish(context.Background(), &event))
+
+	assert.Len(t, handler.events, 2)
+}
+
+type testEventHandler struct {
+	events []ddd.DomainEvent
+	err    error
+}
+
+func (h *testEventHandler) Handle(_ context.Context, event ddd.DomainEvent) error {
+	if h.err != nil {
+		return h.err
+	}
+	h.events = append(h.events, event)
+	return nil
+}
+
+type firstEvent struct {
+	ID uuid.UUID
+}
+
+func (e firstEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e firstEvent) GetName() string {
+	return "firstEvent"
+}
+
+type secondEvent struct {
+	ID uuid.UUID
+}
+
+func (e secondEvent) GetID() uuid.UUID {
+	return e.ID
+}
+
+func (e secondEvent) GetName() string {
+	return "secondEvent"
+}
+
+type namedEvent struct {
+	ddd.BaseDomainEvent
+	Name string
+}
@@ -0,0 +1,80 @@
+package outbox_test
+
+import (
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/outbox"
+	"delivery/internal/pkg/tests"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRegistry_EncodeDecodeDomainEvent(t *testing.T) {
+	registry, err := outbox.NewEventRegistry()
+	require.NoError(t, err)
+
+	err = registry.RegisterDomainEvent(reflect.TypeOf(order.OrderAssignedDomainEvent{}))
+	require.NoError(t, err)
+
+	o, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 5)
+	require.NoError(t, err)
+	courierID := uuid.New()
+	require.NoError(t, o.Assign(courierID))
+
+	events := o.GetDomainEvents()
+	require.Len(t, events, 2)
+
+	message, err := outbox.EncodeDomainEvent(events[1])
+	require.NoError(t, err)
+	assert.Equal(t, events[1].GetID(), message.ID)
+	assert.Equal(t, "OrderAssignedDomainEvent", message.Name)
+
+	decoded, err := registry.DecodeDomainEvent(&message)
+	require.NoError(t, err)
+
+	event, ok := decoded.(*order.OrderAssignedDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, events[1].GetID(), event.GetID())
+	assert.Equal(t, o.ID(), event.OrderID)
+	assert.Equal(t, courierID, event.CourierID)
+	assert.Equal(t, order.StatusAssigned, event.Status)
+}
+
+func TestEventRegistry_DecodeDomainEventWithOwnNameField(t *testing.T) {
+	registry, err := outbox.NewEventRegistry()
+	require.NoError(t, err)
+
+	err = registry.RegisterDomainEvent(reflect.TypeOf(courier.CourierCreatedDomainEvent{}))
+	require.NoError(t, err)
+
+	c := tests.CreateCourier("Alice", 1, kernel.MinLocation())
+	message, err := outbox.EncodeDomainEvent(courier.NewCourierCreatedDomainEvent(c))
+	require.NoError(t, err)
+
+	decoded, err := registry.DecodeDomainEvent(&message)
+	require.NoError(t, err)
+
+	event, ok := decoded.(*courier.CourierCreatedDomainEvent)
+	require.True(t, ok)
+	assert.Equal(t, "CourierCreatedDomainEvent", event.GetName())
+	assert.Equal(t, "Alice", event.Name)
+}
+
+func TestEventRegistry_DecodeUnknownDomainEvent(t *testing.T) {
+	registry, err := outbox.NewEventRegistry()
+	require.NoError(t, err)
+
+	o, err := order.NewOrder(uuid.New(), kernel.MinLocation(), kernel.MinLocation(), 5)
+	require.NoError(t, err)
+
+	message, err := outbox.EncodeDomainEvent(o.GetDomainEvents()[0])
+	require.NoError(t, err)
+
+	_, err = registry.DecodeDomainEvent(&message)
+	assert.Error(t, err)
+}
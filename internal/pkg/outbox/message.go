@@ -5,14 +5,54 @@ import (
 	"time"
 )
 
+// publishRetryDelay - задержка после первой неудачной попытки, дальше она удваивается
+// до maxPublishRetryDelay. Сообщение публикуется повторно, пока не будет опубликовано
+const (
+	publishRetryDelay    = time.Second
+	maxPublishRetryDelay = time.Minute
+)
+
 type Message struct {
 	ID             uuid.UUID
 	Name           string
 	Payload        []byte
 	OccurredAtUtc  time.Time
 	ProcessedAtUtc *time.Time
+	// Attempts - число неудачных попыток публикации, LastError - ошибка последней из них.
+	// Следующая попытка откладывается до NextAttemptAtUtc
+	Attempts         int `gorm:"not null;default:0"`
+	LastError        *string
+	NextAttemptAtUtc *time.Time
 }
 
 func (Message) TableName() string {
 	return "outbox"
 }
+
+func (m *Message) MarkProcessed(now time.Time) {
+	m.ProcessedAtUtc = &now
+}
+
+// MarkFailed откладывает следующую попытку публикации
+func (m *Message) MarkFailed(err error, now time.Time) {
+	m.Attempts++
+	m.setLastError(err)
+
+	delay := maxPublishRetryDelay
+	if shift := m.Attempts - 1; shift < 32 && publishRetryDelay<<shift < maxPublishRetryDelay {
+		delay = publishRetryDelay << shift
+	}
+
+	nextAttemptAt := now.Add(delay)
+	m.NextAttemptAtUtc = &nextAttemptAt
+}
+
+// IsDue проверяет, пора ли публиковать сообщение
+func (m *Message) IsDue(now time.Time) bool {
+	return m.NextAttemptAtUtc == nil || !m.NextAttemptAtUtc.After(now)
+}
+
+func (m *Message) setLastError(err error) {
+	text := err.Error()
+	m.LastError = &text
+}
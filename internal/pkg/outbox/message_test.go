@@ -0,0 +1,34 @@
+package outbox_test
+
+import (
+	"delivery/internal/pkg/outbox"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage_MarkFailed(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	message := &outbox.Message{}
+	assert.True(t, message.IsDue(now))
+
+	message.MarkFailed(errors.New("broker is down"), now)
+	require.NotNil(t, message.NextAttemptAtUtc)
+	assert.Equal(t, now.Add(time.Second), *message.NextAttemptAtUtc)
+	assert.Equal(t, "broker is down", *message.LastError)
+	assert.False(t, message.IsDue(now))
+	assert.True(t, message.IsDue(now.Add(time.Second)))
+
+	message.MarkFailed(errors.New("broker is down"), now)
+	assert.Equal(t, now.Add(2*time.Second), *message.NextAttemptAtUtc)
+
+	// задержка ограничена, и сообщение не перестает публиковаться при долгой недоступности брокера
+	for range 100 {
+		message.MarkFailed(errors.New("broker is down"), now)
+	}
+	assert.Equal(t, now.Add(time.Minute), *message.NextAttemptAtUtc)
+	assert.Nil(t, message.ProcessedAtUtc)
+}
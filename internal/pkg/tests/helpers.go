@@ -17,8 +17,19 @@ func CreateLocation(x, y int) kernel.Location {
 	return l
 }
 
+// CreateCourier создает пешего курьера, который уже вышел на смену
 func CreateCourier(name string, speed int, location kernel.Location) *courier.Courier {
-	c, err := courier.NewCourier(name, speed, location)
+	return CreateCourierWithVehicle(name, courier.VehicleFoot, speed, location)
+}
+
+// CreateCourierWithVehicle создает курьера на транспорте vehicle, который уже вышел на смену
+func CreateCourierWithVehicle(name string, vehicle courier.VehicleType, speed int, location kernel.Location) *courier.Courier {
+	c, err := courier.NewCourierWithVehicle(name, vehicle, speed, location)
+	if err != nil {
+		panic(err)
+	}
+
+	err = c.StartShift()
 	if err != nil {
 		panic(err)
 	}
@@ -27,7 +38,7 @@ func CreateCourier(name string, speed int, location kernel.Location) *courier.Co
 }
 
 func CreateOrder(uuid uuid.UUID, location kernel.Location, volume int) *order.Order {
-	o, err := order.NewOrder(uuid, location, volume)
+	o, err := order.NewOrder(uuid, location, location, volume)
 	if err != nil {
 		panic(err)
 	}